@@ -0,0 +1,32 @@
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"univ/handlers"
+)
+
+// runImport 는 `univ import` 서브커맨드입니다.
+// 학과 정보/입시 결과 CSV를 읽어 설정된 DB의 departments, admission_rules 테이블에 적재합니다.
+// 서버와 같은 설정 플래그(-config, -db-dsn, -data-year 등)를 받습니다.
+//
+//	univ import -departments data/departments.csv -results data/adiga_2025_admission_results_final.csv
+func runImport(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	cfg := loadConfig(fs, args)
+
+	store := openStore(cfg)
+	defer store.Close()
+
+	specs := handlers.DefaultCSVSpecs
+	if override := csvSpecs(cfg); override != nil {
+		specs = *override
+	}
+	report, err := handlers.ImportAdmissionData(store, specs, cfg.Data.DepartmentsFile(), cfg.Data.AdmissionResultsFile())
+	if report != nil {
+		report.Log(slog.Default())
+	}
+	if err != nil {
+		fatal("입시 데이터 적재 실패", err)
+	}
+}
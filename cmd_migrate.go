@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"univ/migrations"
+)
+
+// runMigrate 는 `univ migrate` 서브커맨드입니다.
+//
+//	univ migrate up          # 적용되지 않은 마이그레이션을 모두 적용
+//	univ migrate down [n]    # 최근 마이그레이션 n개(기본 1개)를 되돌림
+//	univ migrate status      # 마이그레이션별 적용 상태 출력
+//
+// 명령 앞에 서버와 같은 설정 플래그를 줄 수 있습니다. (예: univ migrate -db-driver postgres -db-dsn ... up)
+func runMigrate(args []string) {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	cfg := loadConfig(fs, args)
+	args = fs.Args()
+	if len(args) == 0 {
+		fatal("마이그레이션 명령이 없습니다", errors.New("사용법: univ migrate [설정 플래그] up | down [n] | status"))
+	}
+
+	store := openStoreWithoutMigrations(cfg)
+	defer store.Close()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrations.Up(store.DB, store.Dialect)
+		for _, m := range applied {
+			slog.Info("마이그레이션 적용", "version", m.Version, "name", m.Name)
+		}
+		if err != nil {
+			fatal("마이그레이션 실패", err)
+		}
+		if len(applied) == 0 {
+			slog.Info("적용할 마이그레이션이 없습니다")
+		}
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				fatal("마이그레이션 되돌리기 실패", fmt.Errorf("되돌릴 개수가 올바르지 않습니다: %q", args[1]))
+			}
+			steps = n
+		}
+		reverted, err := migrations.Down(store.DB, store.Dialect, steps)
+		for _, m := range reverted {
+			slog.Info("마이그레이션 되돌림", "version", m.Version, "name", m.Name)
+		}
+		if err != nil {
+			fatal("마이그레이션 되돌리기 실패", err)
+		}
+
+	case "status":
+		statuses, err := migrations.StatusOf(store.DB, store.Dialect)
+		if err != nil {
+			fatal("마이그레이션 상태 조회 실패", err)
+		}
+		for _, st := range statuses {
+			state := "미적용"
+			if st.Applied {
+				state = "적용됨 " + st.AppliedAt
+			}
+			fmt.Fprintf(os.Stdout, "%04d  %-45s %s\n", st.Version, st.Name, state)
+		}
+
+	default:
+		fatal("마이그레이션 명령 실패", fmt.Errorf("알 수 없는 migrate 명령: %q (up | down [n] | status)", args[0]))
+	}
+}
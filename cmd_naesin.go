@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"univ/handlers"
+)
+
+// runParseNaesin 은 `univ parse-naesin` 서브커맨드입니다.
+// 내신 성적 파일(XLSX, CSV, 나이스 학생부 텍스트)을 POST /api/naesin/import 와 같은 방법으로 읽어 결과 JSON 을 출력합니다.
+// -check 에 디렉터리를 주면 그 안의 예시 파일마다 같은 이름의 .json(기대 결과)과 비교하고, 다르면 종료 코드 1 로 끝납니다.
+// 파서를 고친 PR 에서 data/naesin-samples 의 결과가 바뀌지 않았는지 CI 로 확인할 때 씁니다.
+//
+//	univ parse-naesin -file 학생부.txt -curriculumVersion 2015
+//	univ parse-naesin -check data/naesin-samples
+func runParseNaesin(args []string) {
+	fs := flag.NewFlagSet("parse-naesin", flag.ExitOnError)
+	file := fs.String("file", "", "읽을 성적 파일 (XLSX, CSV, 나이스 학생부 텍스트)")
+	version := fs.String("curriculumVersion", handlers.DefaultCurriculumVersion, "과목 코드를 찾을 교육과정 버전")
+	output := fs.String("o", "", "출력 파일 (비우면 표준 출력)")
+	check := fs.String("check", "", "예시 파일과 기대 결과(.json)를 비교할 디렉터리")
+	cfg := loadConfig(fs, args)
+	if (*file == "") == (*check == "") {
+		fatal("내신 성적 파일 읽기 실패", errors.New("-file 과 -check 중 하나가 필요합니다"))
+	}
+
+	store := openStore(cfg)
+	defer store.Close()
+	catalog, err := store.Subjects.LoadSubjectCatalog()
+	if err != nil {
+		fatal("과목 목록 조회 실패", err)
+	}
+
+	if *check != "" {
+		checkNaesinSamples(*check, catalog)
+		return
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		fatal("내신 성적 파일 읽기 실패", err)
+	}
+	result, err := handlers.ImportNaesinFile(data, catalog, *version)
+	if err != nil {
+		fatal("내신 성적 파일 읽기 실패", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fatal("출력 파일 생성 실패", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(result); err != nil {
+		fatal("결과 출력 실패", err)
+	}
+}
+
+// checkNaesinSamples 는 dir 의 예시 파일(.txt, .csv, .xlsx)을 읽어 같은 이름의 .json 과 비교합니다.
+// 교육과정 버전은 기대 결과의 curriculumVersion 을 씁니다.
+func checkNaesinSamples(dir string, catalog *handlers.SubjectCatalog) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		fatal("예시 디렉터리 읽기 실패", err)
+	}
+	checked, failed := 0, 0
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".txt" && ext != ".csv" && ext != ".xlsx") {
+			continue
+		}
+		name := filepath.Join(dir, entry.Name())
+		if err := checkNaesinSample(name, strings.TrimSuffix(name, ext)+".json", catalog); err != nil {
+			fmt.Printf("FAIL %s: %v\n", name, err)
+			failed++
+		} else {
+			fmt.Printf("ok   %s\n", name)
+		}
+		checked++
+	}
+	if failed > 0 {
+		slog.Error("예시 파일의 결과가 기대와 다릅니다", "checked", checked, "failed", failed)
+		os.Exit(1)
+	}
+	slog.Info("예시 파일의 결과가 모두 기대와 같습니다", "checked", checked)
+}
+
+func checkNaesinSample(name, expectedName string, catalog *handlers.SubjectCatalog) error {
+	expectedData, err := os.ReadFile(expectedName)
+	if err != nil {
+		return fmt.Errorf("기대 결과 파일이 없습니다 (univ parse-naesin -file %s -o %s 로 만듦): %w", name, expectedName, err)
+	}
+	var expected struct {
+		CurriculumVersion string `json:"curriculumVersion"`
+	}
+	if err := json.Unmarshal(expectedData, &expected); err != nil {
+		return fmt.Errorf("기대 결과 파싱 실패: %w", err)
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	result, err := handlers.ImportNaesinFile(data, catalog, expected.CurriculumVersion)
+	if err != nil {
+		return err
+	}
+
+	// 필드 순서나 공백과 상관없이 비교하도록 둘 다 일반 JSON 값으로 바꿉니다.
+	actualData, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	var want, got interface{}
+	json.Unmarshal(expectedData, &want)
+	json.Unmarshal(actualData, &got)
+	if reflect.DeepEqual(want, got) {
+		return nil
+	}
+	var pretty bytes.Buffer
+	json.Indent(&pretty, actualData, "", "  ")
+	return fmt.Errorf("결과가 기대와 다릅니다. 실제 결과:\n%s", pretty.String())
+}
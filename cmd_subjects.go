@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"univ/handlers"
+)
+
+// runImportSubjects 는 `univ import-subjects` 서브커맨드입니다.
+// JSON 과목 목록 파일을 검증한 뒤 설정된 DB의 과목 목록 테이블을 교체합니다.
+// 실행 중인 서버는 다음 과목 조회 때 바뀐 버전을 알아차리고 새 목록을 읽습니다.
+//
+//	univ import-subjects -file subjects.json
+func runImportSubjects(args []string) {
+	fs := flag.NewFlagSet("import-subjects", flag.ExitOnError)
+	file := fs.String("file", "", "적재할 과목 목록 JSON 파일 (형식은 export-subjects 출력과 같음)")
+	cfg := loadConfig(fs, args)
+	if *file == "" {
+		fatal("과목 목록 적재 실패", errors.New("-file 플래그가 필요합니다"))
+	}
+
+	catalog, err := handlers.ReadSubjectCatalogFile(*file)
+	if err != nil {
+		fatal("과목 목록 파일 읽기 실패", err)
+	}
+
+	store := openStore(cfg)
+	defer store.Close()
+
+	if err := handlers.ImportSubjectCatalog(store, catalog); err != nil {
+		fatal("과목 목록 적재 실패", err)
+	}
+	slog.Info("과목 목록을 적재했습니다", "version", catalog.Version,
+		"classifications", len(catalog.Classifications), "curriculums", len(catalog.Curriculums),
+		"naesin_subjects", len(catalog.NaesinSubjects), "suneung_subjects", len(catalog.SuneungSubjects))
+}
+
+// runExportSubjects 는 `univ export-subjects` 서브커맨드입니다.
+// 현재 DB의 과목 목록을 import-subjects 가 읽을 수 있는 JSON 으로 출력합니다. (-o 가 없으면 표준 출력)
+//
+//	univ export-subjects -o subjects.json
+func runExportSubjects(args []string) {
+	fs := flag.NewFlagSet("export-subjects", flag.ExitOnError)
+	output := fs.String("o", "", "출력 파일 (비우면 표준 출력)")
+	cfg := loadConfig(fs, args)
+
+	store := openStore(cfg)
+	defer store.Close()
+
+	catalog, err := store.Subjects.LoadSubjectCatalog()
+	if err != nil {
+		fatal("과목 목록 조회 실패", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fatal("출력 파일 생성 실패", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(catalog); err != nil {
+		fatal("과목 목록 출력 실패", err)
+	}
+}
+
+// runCheckSubjects 는 `univ check-subjects` 서브커맨드입니다.
+// 과목 목록의 일관성(코드 중복, 없는 상위 코드, 이름 충돌)을 검사하여 문제를 한 줄씩 출력하고, 문제가 있으면 종료 코드 1 로 끝납니다.
+// -file 을 주면 적재 전의 JSON 파일을, 없으면 현재 DB의 목록을 검사합니다. CI 에서 목록 파일을 고친 PR 을 검사할 때 씁니다.
+//
+//	univ check-subjects -file subjects.json
+func runCheckSubjects(args []string) {
+	fs := flag.NewFlagSet("check-subjects", flag.ExitOnError)
+	file := fs.String("file", "", "검사할 과목 목록 JSON 파일 (비우면 DB의 목록)")
+	asJSON := fs.Bool("json", false, "문제 목록을 JSON 으로 출력")
+	cfg := loadConfig(fs, args)
+
+	var catalog *handlers.SubjectCatalog
+	var err error
+	if *file != "" {
+		catalog, err = handlers.ReadSubjectCatalogFile(*file)
+	} else {
+		store := openStore(cfg)
+		defer store.Close()
+		catalog, err = store.Subjects.LoadSubjectCatalog()
+	}
+	if err != nil {
+		fatal("과목 목록 읽기 실패", err)
+	}
+
+	issues := catalog.Check()
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(issues)
+	} else {
+		for _, issue := range issues {
+			fmt.Println(issue)
+		}
+	}
+	if len(issues) > 0 {
+		slog.Error("과목 목록에 문제가 있습니다", "version", catalog.Version, "issues", len(issues))
+		os.Exit(1)
+	}
+	slog.Info("과목 목록에 문제가 없습니다", "version", catalog.Version)
+}
+
+// checkSubjectCatalogOnStartup 은 서버 시작 시 과목 목록을 검사합니다. (subjects.checkOnStartup)
+// "warn" 이면 문제를 로그에 남기고 계속하며, "fail" 이면 문제가 있을 때 종료합니다.
+func checkSubjectCatalogOnStartup(server *handlers.Server, mode string) {
+	if mode == "off" {
+		return
+	}
+	catalog, issues, err := server.CheckSubjectCatalog()
+	if err != nil {
+		fatal("과목 목록 읽기 실패", err)
+	}
+	for _, issue := range issues {
+		slog.Warn("과목 목록 문제", "kind", issue.Kind, "path", issue.Path, "code", issue.Code, "message", issue.Message)
+	}
+	if len(issues) == 0 {
+		return
+	}
+	if mode == "fail" {
+		fatal("서버 시작 중단", fmt.Errorf("과목 목록(version %s)에 문제가 %d개 있습니다 (univ check-subjects 로 확인)", catalog.Version, len(issues)))
+	}
+	slog.Warn("과목 목록에 문제가 있습니다 (univ check-subjects 로 확인)", "version", catalog.Version, "issues", len(issues))
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultIsValid(t *testing.T) {
+	require.NoError(t, Default().Validate())
+}
+
+// 드라이버가 빌드에 포함되었는지는 연결할 때(handlers.OpenStore) 확인하므로, 설정 검사는 빌드 태그와 관계없이 같습니다.
+func TestValidateDatabaseDriver(t *testing.T) {
+	c := Default()
+	c.Database.Driver = "postgres"
+	c.Database.DSN = "postgres://localhost/univ"
+	assert.NoError(t, c.Validate())
+
+	c.Database.Driver = "mysql"
+	err := c.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "database.driver")
+}
+
+// 기본 기준값은 빈 데이터를 거르고, 0 을 직접 지정했을 때만 검사를 끕니다.
+func TestThresholdDefaultsAndOptOut(t *testing.T) {
+	def := Default().Data.Thresholds
+	assert.Greater(t, def.MinAdmissionRows, 0)
+	assert.Greater(t, def.MaxUnmatchedRatio, 0.0)
+	assert.Less(t, def.MaxUnmatchedRatio, 1.0)
+	assert.Greater(t, def.MaxUnparseableRatio, 0.0)
+	assert.Less(t, def.MaxUnparseableRatio, 1.0)
+
+	t.Setenv("UNIV_MIN_ADMISSION_ROWS", "0")
+	t.Setenv("UNIV_MAX_UNMATCHED_RATIO", "0")
+	c, err := Load(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, c.Data.Thresholds.MinAdmissionRows)
+	assert.Equal(t, 0.0, c.Data.Thresholds.MaxUnmatchedRatio)
+	assert.Equal(t, def.MaxUnparseableRatio, c.Data.Thresholds.MaxUnparseableRatio, "지정하지 않은 값은 기본값")
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"univ/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAdminTestServer(t *testing.T, admin config.AdminConfig) http.Handler {
+	t.Helper()
+	store := newMemoryStore(t)
+	cfg := config.Default()
+	cfg.Admin = admin
+	return NewServer(ServerOptions{Config: cfg, Store: store}).Router()
+}
+
+var adminRoutes = []struct{ method, path string }{
+	{http.MethodGet, "/api/admin/config"},
+	{http.MethodGet, "/api/admin/load-report"},
+	{http.MethodPost, "/api/admin/reload-data"},
+}
+
+// 관리자 계정이 없으면 인증 없이 열지 않고 모든 관리자 API를 403 으로 거절합니다.
+func TestAdminWithoutCredentialsIsForbidden(t *testing.T) {
+	router := newAdminTestServer(t, config.AdminConfig{})
+
+	for _, route := range adminRoutes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(route.method, route.path, nil))
+
+			require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+			var body ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, ErrCodeForbidden, body.Error.Code)
+		})
+	}
+}
+
+func TestAdminBasicAuth(t *testing.T) {
+	router := newAdminTestServer(t, config.AdminConfig{Username: "admin", Password: "secret"})
+
+	tests := []struct {
+		name       string
+		user, pass string
+		wantStatus int
+	}{
+		{"인증 없음", "", "", http.StatusUnauthorized},
+		{"비밀번호 틀림", "admin", "wrong", http.StatusUnauthorized},
+		{"인증 성공", "admin", "secret", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/admin/config", nil)
+			if tt.user != "" {
+				req.SetBasicAuth(tt.user, tt.pass)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+			} else {
+				assert.Contains(t, w.Body.String(), `"password":"[REDACTED]"`)
+			}
+		})
+	}
+}
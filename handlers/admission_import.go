@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// --- 입시 데이터 DB 적재 ---
+// 학과 정보/입시 결과 CSV를 읽어 departments, admission_rules 테이블에 적재합니다.
+// 테이블은 migrations 패키지(0003_create_departments_admission_rules)에서 만듭니다.
+// CSV 해석(열 매핑, 학과 매칭, 숫자 변환)은 메모리 로드와 같은 buildAdmissionDataset 을 그대로 사용합니다.
+
+// ImportAdmissionData 는 두 CSV 파일을 읽어 저장소 DB(SQLite/PostgreSQL)의 departments / admission_rules 테이블을 새 데이터로 교체합니다.
+// 전체 작업은 하나의 트랜잭션으로 처리되므로, 실패하면 기존 데이터가 그대로 남습니다.
+func ImportAdmissionData(s *Store, specs CSVSpecs, departmentInfoPath, admissionResultPath string) (*AdmissionLoadReport, error) {
+	dataset := buildAdmissionDataset(specs, s.Universities, departmentInfoPath, admissionResultPath)
+	report := dataset.Report
+	if report.Department.Error != "" || report.Admission.Error != "" {
+		return report, fmt.Errorf("CSV 로드 실패: 학과 정보=%q, 입시 결과=%q", report.Department.Error, report.Admission.Error)
+	}
+
+	tx, err := s.DB.Begin()
+	if err != nil {
+		return report, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM admission_rules"); err != nil {
+		return report, err
+	}
+	if _, err := tx.Exec("DELETE FROM departments"); err != nil {
+		return report, err
+	}
+
+	deptStmt, err := tx.Prepare(s.Dialect.Bind(`INSERT INTO departments
+        (id, university_id, campus_id, university_name, campus, name, department_code, region)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT (id) DO NOTHING`))
+	if err != nil {
+		return report, err
+	}
+	defer deptStmt.Close()
+
+	ruleStmt, err := tx.Prepare(s.Dialect.Bind(`INSERT INTO admission_rules
+        (id, department_id, admission_type, detail_admission_type, competition_rate, cut50, cut70)
+        VALUES (?, ?, ?, ?, ?, ?, ?)
+        ON CONFLICT (id) DO UPDATE SET
+            department_id = excluded.department_id,
+            admission_type = excluded.admission_type,
+            detail_admission_type = excluded.detail_admission_type,
+            competition_rate = excluded.competition_rate,
+            cut50 = excluded.cut50,
+            cut70 = excluded.cut70`))
+	if err != nil {
+		return report, err
+	}
+	defer ruleStmt.Close()
+
+	departments := 0
+	for _, rec := range dataset.Records {
+		res, err := deptStmt.Exec(rec.DepartmentID, rec.UniversityID, rec.CampusID,
+			rec.UniversityName, rec.Campus, rec.DepartmentName, rec.DepartmentCode, rec.Region)
+		if err != nil {
+			return report, fmt.Errorf("학과 적재 실패 (%s %s): %w", rec.UniversityName, rec.DepartmentName, err)
+		}
+		if n, _ := res.RowsAffected(); n > 0 {
+			departments++
+		}
+		if _, err := ruleStmt.Exec(rec.AdmissionProgramID, rec.DepartmentID, rec.AdmissionType,
+			rec.DetailAdmissionType, rec.CompetitionRate, rec.Cut50, rec.Cut70); err != nil {
+			return report, fmt.Errorf("전형 적재 실패 (%s %s %s): %w", rec.UniversityName, rec.DepartmentName, rec.DetailAdmissionType, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return report, err
+	}
+	slog.Info("입시 데이터 DB 적재 완료", "dialect", s.Dialect, "departments", departments, "admission_rules", len(dataset.Records))
+	return report, nil
+}
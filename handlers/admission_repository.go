@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"strings"
+)
+
+// --- 입시 결과 조회 Repository ---
+// FilterUniversities 등 핸들러는 입시 결과가 메모리(CSV 스냅샷)에 있는지 DB(SQLite/PostgreSQL)에 있는지 알 필요 없이
+// AdmissionRepository 를 통해서만 조회합니다.
+
+// AdmissionQuery 는 입시 결과 조회 조건입니다. 빈 값인 조건은 적용하지 않습니다.
+type AdmissionQuery struct {
+	UniversityID          string
+	DepartmentID          string
+	DepartmentCode        string
+	Region                string
+	AdmissionTypeContains string // 전형 유형에 이 문자열이 포함된 결과만 (예: "수능", "교과")
+}
+
+// AdmissionRepository 는 입시 결과 저장소입니다. 구현은 Store.Admissions 로 선택합니다.
+type AdmissionRepository interface {
+	FindAdmissions(q AdmissionQuery) ([]AdmissionResult, error)
+	// CountAdmissions 는 조회 가능한 입시 결과(전형) 수를 반환합니다.
+	CountAdmissions() (int, error)
+}
+
+func (q AdmissionQuery) matches(r AdmissionResult) bool {
+	if q.UniversityID != "" && r.UniversityID != q.UniversityID {
+		return false
+	}
+	if q.DepartmentID != "" && r.DepartmentID != q.DepartmentID {
+		return false
+	}
+	if q.DepartmentCode != "" && r.DepartmentCode != q.DepartmentCode {
+		return false
+	}
+	if q.Region != "" && r.Region != q.Region {
+		return false
+	}
+	if q.AdmissionTypeContains != "" && !strings.Contains(r.AdmissionType, q.AdmissionTypeContains) {
+		return false
+	}
+	return true
+}
+
+// --- 메모리(CSV 스냅샷) 구현 ---
+
+// DatasetAdmissionRepository 는 Dataset 이 돌려주는 현재 입시 데이터 스냅샷(보통 Server.CurrentDataset)에서 조회합니다.
+// 한 번의 FindAdmissions 호출은 하나의 스냅샷만 보므로, 도중에 데이터가 교체되어도 결과가 섞이지 않습니다.
+type DatasetAdmissionRepository struct {
+	Dataset func() *AdmissionDataset
+}
+
+func (r DatasetAdmissionRepository) FindAdmissions(q AdmissionQuery) ([]AdmissionResult, error) {
+	dataset := r.Dataset()
+	results := make([]AdmissionResult, 0)
+	for _, record := range dataset.Records {
+		if q.matches(record) {
+			results = append(results, record)
+		}
+	}
+	return results, nil
+}
+
+func (r DatasetAdmissionRepository) CountAdmissions() (int, error) {
+	return len(r.Dataset().Records), nil
+}
+
+// DatasetDepartmentRepository 는 현재 입시 데이터 스냅샷에 등장한 학과에서 찾습니다.
+type DatasetDepartmentRepository struct {
+	Dataset func() *AdmissionDataset
+}
+
+func (r DatasetDepartmentRepository) FindDepartment(id string) (*Department, error) {
+	for _, record := range r.Dataset().Records {
+		if record.DepartmentID == id {
+			return &Department{
+				ID:             record.DepartmentID,
+				UniversityID:   record.UniversityID,
+				CampusID:       record.CampusID,
+				UniversityName: record.UniversityName,
+				Campus:         record.Campus,
+				Name:           record.DepartmentName,
+				DepartmentCode: record.DepartmentCode,
+				Region:         record.Region,
+			}, nil
+		}
+	}
+	return nil, nil
+}
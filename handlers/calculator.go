@@ -3,6 +3,8 @@ package handlers
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"slices"
 	"sort"
 	"strconv"
 )
@@ -11,19 +13,35 @@ import (
 
 // GpaScore 는 학생의 한 학기 교과 성적을 나타냅니다.
 type GpaScore struct {
-	SubjectName    string  `json:"과목명"`
-	Category       string  `json:"과목분류"`
-	Units          float64 `json:"이수단위"`
-	Rank           int     `json:"석차등급"`
-	Achievement    string  `json:"성취도"`
-	Year           int     `json:"학년"`
-	Semester       int     `json:"학기"`
-	ConvertedScore float64 `json:"-"` // 환산점수
-	YearlyWeight   float64 `json:"-"` // 학년가중치
-	FinalWeight    float64 `json:"-"` // 최종가중치
+	SubjectName    string   `json:"과목명"`
+	SubjectCode    string   `json:"과목코드,omitempty"`
+	Category       string   `json:"과목분류"`
+	Categories     []string `json:"과목분류목록,omitempty"` // 대학 기준으로 과목이 드는 교과 분류 이름 (SubjectCatalog.GpaScores)
+	Units          float64  `json:"이수단위"`
+	Rank           int      `json:"석차등급"`
+	RankScale      int      `json:"석차등급체계,omitempty"` // 5(2022 개정) 또는 9, 0 이면 9등급제
+	Achievement    string   `json:"성취도"`
+	Year           int      `json:"학년"`
+	Semester       int      `json:"학기"`
+	ConvertedScore float64  `json:"-"` // 환산점수
+	YearlyWeight   float64  `json:"-"` // 학년가중치
+	FinalWeight    float64  `json:"-"` // 최종가중치
+}
+
+// inCategory 는 성적이 교과 분류 label 에 드는지 확인합니다. Category 가 같거나 Categories 에 있으면 듭니다.
+func (g GpaScore) inCategory(label string) bool {
+	return g.Category == label || slices.Contains(g.Categories, label)
 }
 
-// CsatScore 는 학생의 수능 성적을 나타냅니다.
+// rankScale 은 성적의 석차등급 체계입니다. 지정하지 않았으면 9등급제입니다.
+func (g GpaScore) rankScale() int {
+	if g.RankScale == 0 {
+		return RankScale9
+	}
+	return g.RankScale
+}
+
+// CsatScore 는 학생의 수능 성적을 나타냅니다. 수능은 교육과정과 관계없이 9등급제입니다.
 type CsatScore struct {
 	SubjectName    string  `json:"선택과목"`
 	StandardScore  int     `json:"표준점수"`
@@ -61,43 +79,87 @@ type ScoreCalculator struct {
 	currentCsatData    map[string]CsatScore
 	finalScore         float64
 	scoreSource        string
-	functionDispatcher map[string]func(params json.RawMessage) error
+	registry           *CalculatorRegistry
+	logger             *slog.Logger // nil 이면 slog.Default()
+	metrics            *Metrics     // nil 이면 지표를 기록하지 않음
 }
 
-// NewScoreCalculator 는 ScoreCalculator의 생성자 함수입니다.
-func NewScoreCalculator(gpaScores []GpaScore, csatScores map[string]CsatScore) *ScoreCalculator {
-	sc := &ScoreCalculator{
-		originalGpaScores:  gpaScores,
-		originalCsatScores: csatScores,
-	}
+// CalculationFunc 는 파이프라인 한 단계(function_name)의 구현입니다.
+type CalculationFunc func(sc *ScoreCalculator, params json.RawMessage) error
 
-	sc.functionDispatcher = map[string]func(params json.RawMessage) error{
+// CalculatorRegistry 는 function_name -> 구현 함수 맵입니다.
+// Server 마다 하나씩 가지므로, 서버(또는 테스트)별로 단계를 추가하거나 바꿀 수 있습니다.
+type CalculatorRegistry struct {
+	funcs map[string]CalculationFunc
+}
+
+// NewCalculatorRegistry 는 기본 단계가 모두 등록된 레지스트리를 만듭니다.
+func NewCalculatorRegistry() *CalculatorRegistry {
+	return &CalculatorRegistry{funcs: map[string]CalculationFunc{
 		// GPA Functions
-		"FILTER_SUBJECTS_BY_CATEGORY":       sc.filterSubjectsByCategory,
-		"SELECT_TOP_N_UNITS_PER_CATEGORY":   sc.selectTopNUnitsPerCategory,
-		"APPLY_GRADE_TO_SCORE_MAP":          sc.applyGradeToScoreMap,
-		"APPLY_GRADE_LEVEL_WEIGHTING":       sc.applyGradeLevelWeighting,
-		"CALCULATE_WEIGHTED_AVERAGE":        sc.calculateWeightedAverage,
-		"APPLY_JINRO_SUBJECT_BONUS_PERCENT": sc.applyJinroSubjectBonusPercent,
+		"FILTER_SUBJECTS_BY_CATEGORY":       (*ScoreCalculator).filterSubjectsByCategory,
+		"SELECT_TOP_N_UNITS_PER_CATEGORY":   (*ScoreCalculator).selectTopNUnitsPerCategory,
+		"APPLY_GRADE_TO_SCORE_MAP":          (*ScoreCalculator).applyGradeToScoreMap,
+		"APPLY_GRADE_LEVEL_WEIGHTING":       (*ScoreCalculator).applyGradeLevelWeighting,
+		"CALCULATE_WEIGHTED_AVERAGE":        (*ScoreCalculator).calculateWeightedAverage,
+		"APPLY_JINRO_SUBJECT_BONUS_PERCENT": (*ScoreCalculator).applyJinroSubjectBonusPercent,
 
 		// CSAT Functions
-		"UTILIZE_CSAT_SCORE_TYPE":        sc.utilizeCsatScoreType,
-		"APPLY_ABSOLUTE_SCORE_POLICY":    sc.applyAbsoluteScorePolicy,
-		"APPLY_SUBJECT_WEIGHTING":        sc.applySubjectWeighting,
-		"SELECT_TOP_N_AREAS":             sc.selectTopNAreas,
-		"CALCULATE_ARITHMETIC_AVERAGE":   sc.calculateArithmeticAverage,
-		"APPLY_SCORE_ADJUSTMENT_PERCENT": sc.applyScoreAdjustmentPercent,
+		"UTILIZE_CSAT_SCORE_TYPE":        (*ScoreCalculator).utilizeCsatScoreType,
+		"APPLY_ABSOLUTE_SCORE_POLICY":    (*ScoreCalculator).applyAbsoluteScorePolicy,
+		"APPLY_SUBJECT_WEIGHTING":        (*ScoreCalculator).applySubjectWeighting,
+		"SELECT_TOP_N_AREAS":             (*ScoreCalculator).selectTopNAreas,
+		"CALCULATE_ARITHMETIC_AVERAGE":   (*ScoreCalculator).calculateArithmeticAverage,
+		"APPLY_SCORE_ADJUSTMENT_PERCENT": (*ScoreCalculator).applyScoreAdjustmentPercent,
 
 		// 명세서에 있었지만, 핵심 기능 외의 함수들 (필요 시 구현)
-		// "APPLY_ATTENDANCE_SCORE": sc.applyAttendanceScore,
-		// "APPLY_PERCENTAGE_WEIGHTING": sc.applyPercentageWeighting,
+		// "APPLY_ATTENDANCE_SCORE": (*ScoreCalculator).applyAttendanceScore,
+		// "APPLY_PERCENTAGE_WEIGHTING": (*ScoreCalculator).applyPercentageWeighting,
+	}}
+}
+
+// Register 는 단계 구현을 추가하거나 같은 이름의 기존 구현을 바꿉니다.
+func (r *CalculatorRegistry) Register(funcName string, fn CalculationFunc) {
+	r.funcs[funcName] = fn
+}
+
+// Lookup 은 단계 구현을 찾습니다.
+func (r *CalculatorRegistry) Lookup(funcName string) (CalculationFunc, bool) {
+	fn, ok := r.funcs[funcName]
+	return fn, ok
+}
+
+// NewCalculator 는 이 레지스트리의 단계를 사용하는 ScoreCalculator 를 만듭니다.
+func (r *CalculatorRegistry) NewCalculator(gpaScores []GpaScore, csatScores map[string]CsatScore) *ScoreCalculator {
+	return &ScoreCalculator{
+		originalGpaScores:  gpaScores,
+		originalCsatScores: csatScores,
+		registry:           r,
 	}
+}
+
+// WithLogger 는 계산 단계 로그를 남길 로거를 지정합니다. 요청 처리 중에는 LoggerFrom(ctx) 를 넘겨 request_id 가 붙게 합니다.
+func (sc *ScoreCalculator) WithLogger(logger *slog.Logger) *ScoreCalculator {
+	sc.logger = logger
 	return sc
 }
 
+func (sc *ScoreCalculator) log() *slog.Logger {
+	if sc.logger == nil {
+		return slog.Default()
+	}
+	return sc.logger
+}
+
+// NewScoreCalculator 는 기본 레지스트리를 사용하는 ScoreCalculator의 생성자 함수입니다.
+func NewScoreCalculator(gpaScores []GpaScore, csatScores map[string]CsatScore) *ScoreCalculator {
+	return NewCalculatorRegistry().NewCalculator(gpaScores, csatScores)
+}
+
 // Calculate 는 JSON 스키마를 받아 최종 점수를 계산합니다.
-func (sc *ScoreCalculator) Calculate(scheme CalculationScheme) (float64, error) {
-	// (이전 코드와 동일 - 변경 없음)
+func (sc *ScoreCalculator) Calculate(scheme CalculationScheme) (score float64, err error) {
+	defer func() { sc.metrics.observeCalculation(scheme.Details.ScoreSource, err) }()
+
 	sc.scoreSource = scheme.Details.ScoreSource
 	pipeline := scheme.Details.Pipeline
 
@@ -118,45 +180,46 @@ func (sc *ScoreCalculator) Calculate(scheme CalculationScheme) (float64, error)
 		return 0, fmt.Errorf("알 수 없는 score_source: %s", sc.scoreSource)
 	}
 
-	fmt.Printf("\n--- '%s' 점수 계산 시작 (Source: %s) ---\n", scheme.AdmissionType, sc.scoreSource)
+	// 단계별 진행 로그는 요청마다, 학과마다 반복되므로 debug 레벨에서만 남깁니다.
+	logger := sc.log().With("admission_type", scheme.AdmissionType, "score_source", sc.scoreSource)
+	logger.Debug("점수 계산 시작")
 
 	sort.SliceStable(pipeline, func(i, j int) bool {
 		return pipeline[i].Step < pipeline[j].Step
 	})
 
 	for _, step := range pipeline {
-		if handler, ok := sc.functionDispatcher[step.FuncName]; ok {
-			fmt.Printf("  [Step %d] 실행: %s\n", step.Step, step.FuncName)
-			err := handler(step.Parameters)
+		if handler, ok := sc.registry.Lookup(step.FuncName); ok {
+			logger.Debug("계산 단계 실행", "step", step.Step, "function", step.FuncName)
+			err := handler(sc, step.Parameters)
+			sc.metrics.observeStep(step.FuncName, err)
 			if err != nil {
 				return 0, fmt.Errorf("Step %d (%s) 실행 중 오류: %w", step.Step, step.FuncName, err)
 			}
 		} else {
-			fmt.Printf("  - 경고: '%s' 함수는 ScoreCalculator에 아직 구현되지 않았습니다. 건너뜁니다.\n", step.FuncName)
+			logger.Warn("구현되지 않은 계산 단계를 건너뜁니다", "step", step.Step, "function", step.FuncName)
+			sc.metrics.observeSkippedStep(step.FuncName)
 		}
 	}
 
-	fmt.Printf("--- 계산 완료: 최종 점수 = %.4f ---\n", sc.finalScore)
+	logger.Debug("점수 계산 완료", "final_score", sc.finalScore)
 	return sc.finalScore, nil
 }
 
 // --- GPA 관련 개별 기능 메소드들 ---
 
+// filterSubjectsByCategory 는 categories 중 하나에 드는 성적만 남깁니다.
+// 분류는 대학별 교과 분류("국영수사과", "전 과목" 등, GpaScore.Categories) 또는 Category 로 비교합니다.
 func (sc *ScoreCalculator) filterSubjectsByCategory(params json.RawMessage) error {
-	// (이전 코드와 동일 - 변경 없음)
 	var p struct {
 		Categories []string `json:"categories"`
 	}
 	if err := json.Unmarshal(params, &p); err != nil {
 		return err
 	}
-	categorySet := make(map[string]bool)
-	for _, cat := range p.Categories {
-		categorySet[cat] = true
-	}
 	var filtered []GpaScore
 	for _, score := range sc.currentGpaData {
-		if categorySet[score.Category] {
+		if slices.ContainsFunc(p.Categories, score.inCategory) {
 			filtered = append(filtered, score)
 		}
 	}
@@ -164,53 +227,100 @@ func (sc *ScoreCalculator) filterSubjectsByCategory(params json.RawMessage) erro
 	return nil
 }
 
+// selectTopNUnitsPerCategory 는 분류마다 등급이 좋은 성적을 N개씩 고릅니다. ("국영수+탐구 상위 N과목")
+// 한 성적이 여러 분류에 들 수 있으므로, 분류 이름 순으로 고르면서 이미 고른 성적은 다시 고르지 않습니다.
 func (sc *ScoreCalculator) selectTopNUnitsPerCategory(params json.RawMessage) error {
-	// (이전 코드와 동일 - 변경 없음)
 	var p struct {
 		CategoryNCounts map[string]int `json:"category_n_counts"`
 	}
 	if err := json.Unmarshal(params, &p); err != nil {
 		return err
 	}
-	subjectsByCategory := make(map[string][]GpaScore)
-	for _, score := range sc.currentGpaData {
-		subjectsByCategory[score.Category] = append(subjectsByCategory[score.Category], score)
+	categories := make([]string, 0, len(p.CategoryNCounts))
+	for category := range p.CategoryNCounts {
+		categories = append(categories, category)
 	}
+	sort.Strings(categories)
+
+	picked := make([]bool, len(sc.currentGpaData))
 	var topSubjects []GpaScore
-	for category, subjects := range subjectsByCategory {
-		sort.SliceStable(subjects, func(i, j int) bool {
-			if subjects[i].Rank != subjects[j].Rank {
-				return subjects[i].Rank < subjects[j].Rank
+	for _, category := range categories {
+		var candidates []int
+		for i, score := range sc.currentGpaData {
+			if !picked[i] && score.inCategory(category) {
+				candidates = append(candidates, i)
 			}
-			return subjects[i].Units > subjects[j].Units
+		}
+		// 5등급제와 9등급제 성적이 섞여 있을 수 있으므로 등급 대신 누적 비율로 비교합니다.
+		sort.SliceStable(candidates, func(i, j int) bool {
+			si, sj := sc.currentGpaData[candidates[i]], sc.currentGpaData[candidates[j]]
+			pi := rankPercentile(si.Rank, si.rankScale())
+			pj := rankPercentile(sj.Rank, sj.rankScale())
+			if pi != pj {
+				return pi < pj
+			}
+			return si.Units > sj.Units
 		})
-		n := p.CategoryNCounts[category]
-		if n > len(subjects) {
-			n = len(subjects)
+		n := max(0, min(p.CategoryNCounts[category], len(candidates)))
+		for _, i := range candidates[:n] {
+			picked[i] = true
+			topSubjects = append(topSubjects, sc.currentGpaData[i])
 		}
-		topSubjects = append(topSubjects, subjects[:n]...)
 	}
 	sc.currentGpaData = topSubjects
 	return nil
 }
 
+// applyGradeToScoreMap 은 석차등급을 환산점수로 바꿉니다.
+// map 은 rank_scale(기본 9) 등급제의 환산표이고, 등급제별 환산표는 maps({"5": {...}, "9": {...}})로 줄 수 있습니다.
+// 성적의 등급제에 맞는 환산표가 없으면 잘못된 점수가 나오지 않도록 오류를 반환합니다.
 func (sc *ScoreCalculator) applyGradeToScoreMap(params json.RawMessage) error {
-	// (이전 코드와 동일 - 변경 없음)
 	var p struct {
-		Map map[string]float64 `json:"map"`
+		Map       map[string]float64            `json:"map"`
+		RankScale int                           `json:"rank_scale"`
+		Maps      map[string]map[string]float64 `json:"maps"`
 	}
 	if err := json.Unmarshal(params, &p); err != nil {
 		return err
 	}
-	gradeMap := make(map[int]float64)
-	for k, v := range p.Map {
-		rank, err := strconv.Atoi(k)
+	if p.RankScale == 0 {
+		p.RankScale = RankScale9
+	}
+
+	gradeMaps := make(map[int]map[int]float64)
+	addMap := func(scale int, m map[string]float64) error {
+		gradeMap := make(map[int]float64)
+		for k, v := range m {
+			rank, err := strconv.Atoi(k)
+			if err != nil {
+				return err
+			}
+			gradeMap[rank] = v
+		}
+		gradeMaps[scale] = gradeMap
+		return nil
+	}
+	if p.Map != nil {
+		if err := addMap(p.RankScale, p.Map); err != nil {
+			return err
+		}
+	}
+	for k, m := range p.Maps {
+		scale, err := strconv.Atoi(k)
 		if err != nil {
+			return fmt.Errorf("maps 의 키는 등급 체계(5 또는 9)여야 합니다: %q", k)
+		}
+		if err := addMap(scale, m); err != nil {
 			return err
 		}
-		gradeMap[rank] = v
 	}
+
 	for i := range sc.currentGpaData {
+		scale := sc.currentGpaData[i].rankScale()
+		gradeMap, ok := gradeMaps[scale]
+		if !ok {
+			return fmt.Errorf("%d등급제 성적(%s)을 환산할 환산표가 없습니다", scale, sc.currentGpaData[i].SubjectName)
+		}
 		if score, ok := gradeMap[sc.currentGpaData[i].Rank]; ok {
 			sc.currentGpaData[i].ConvertedScore = score
 		} else {
@@ -288,7 +398,7 @@ func (sc *ScoreCalculator) applyJinroSubjectBonusPercent(params json.RawMessage)
 
 	aCount := 0
 	for _, score := range sc.originalGpaScores {
-		if score.Category == "진로선택" && score.Achievement == "A" {
+		if score.inCategory("진로선택") && score.Achievement == "A" {
 			aCount++
 		}
 	}
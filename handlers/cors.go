@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- CORS 미들웨어 ---
+// 허용 목록(AllowedOrigins)에 있는 Origin 에만 CORS 헤더를 붙입니다.
+// 사전 요청(preflight, OPTIONS)에는 요청 경로에 실제로 등록된 메소드만 허용 메소드로 알려 주고,
+// Access-Control-Max-Age 로 브라우저가 사전 요청 결과를 캐시하게 합니다.
+
+// CORSPolicy 는 CORS 설정입니다.
+type CORSPolicy struct {
+	AllowedOrigins   []string      // "*" 는 모든 Origin 허용 (이때 AllowCredentials 는 쓸 수 없음)
+	AllowedHeaders   []string      // 사전 요청에서 허용할 요청 헤더
+	AllowCredentials bool          // 쿠키/인증 헤더를 포함한 요청 허용
+	MaxAge           time.Duration // 사전 요청 결과 캐시 시간 (0 이면 헤더를 보내지 않음)
+}
+
+// DefaultCORSAllowedHeaders 는 AllowedHeaders 를 지정하지 않았을 때 허용하는 요청 헤더입니다.
+var DefaultCORSAllowedHeaders = []string{"Accept", "Accept-Language", "Authorization", "Content-Type", "Cache-Control", "If-None-Match", "X-Requested-With"}
+
+// CORS 는 policy 를 적용하는 미들웨어를 만듭니다.
+// routes 는 등록된 경로 목록을 반환하는 함수로(보통 engine.Routes), 첫 사전 요청 때 한 번 읽어 경로별 허용 메소드를 정합니다.
+func CORS(policy CORSPolicy, routes func() gin.RoutesInfo) gin.HandlerFunc {
+	allowAny := slices.Contains(policy.AllowedOrigins, "*")
+	headers := policy.AllowedHeaders
+	if len(headers) == 0 {
+		headers = DefaultCORSAllowedHeaders
+	}
+	allowHeaders := strings.Join(headers, ", ")
+	maxAge := ""
+	if policy.MaxAge > 0 {
+		maxAge = strconv.Itoa(int(policy.MaxAge / time.Second))
+	}
+
+	var (
+		methodsOnce sync.Once
+		methods     *routeMethods
+	)
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		h := c.Writer.Header()
+		preflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
+
+		// 허용 여부가 Origin 에 따라 달라지므로, 캐시가 Origin 별로 응답을 구분하도록 알립니다.
+		if !allowAny {
+			h.Add("Vary", "Origin")
+		}
+		if preflight {
+			h.Add("Vary", "Access-Control-Request-Method")
+			h.Add("Vary", "Access-Control-Request-Headers")
+		}
+
+		if origin == "" {
+			c.Next()
+			return
+		}
+		allowed := allowAny || slices.Contains(policy.AllowedOrigins, origin)
+		if !allowed {
+			if preflight {
+				abortWithError(c, http.StatusForbidden, ErrCodeForbidden, "허용되지 않은 Origin 입니다.", gin.H{"origin": origin})
+				return
+			}
+			// 단순 요청은 그대로 처리하되 CORS 헤더를 붙이지 않으므로 브라우저가 응답을 차단합니다.
+			c.Next()
+			return
+		}
+
+		if preflight {
+			methodsOnce.Do(func() { methods = newRouteMethods(routes()) })
+			allowMethods := methods.lookup(c.Request.URL.Path)
+			if method := c.GetHeader("Access-Control-Request-Method"); !slices.Contains(allowMethods, method) {
+				abortWithError(c, http.StatusForbidden, ErrCodeForbidden, "이 경로에서 허용되지 않은 메소드입니다.",
+					gin.H{"method": method, "allowed": allowMethods})
+				return
+			}
+			h.Set("Access-Control-Allow-Methods", strings.Join(append(allowMethods, http.MethodOptions), ", "))
+			h.Set("Access-Control-Allow-Headers", allowHeaders)
+			if maxAge != "" {
+				h.Set("Access-Control-Max-Age", maxAge)
+			}
+		}
+
+		if allowAny {
+			h.Set("Access-Control-Allow-Origin", "*")
+		} else {
+			h.Set("Access-Control-Allow-Origin", origin)
+			if policy.AllowCredentials {
+				h.Set("Access-Control-Allow-Credentials", "true")
+			}
+		}
+
+		if preflight {
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+		c.Next()
+	}
+}
+
+// routeMethods 는 경로 패턴별로 등록된 메소드 목록입니다.
+type routeMethods struct {
+	patterns []string
+	methods  map[string][]string
+}
+
+func newRouteMethods(routes gin.RoutesInfo) *routeMethods {
+	rm := &routeMethods{methods: map[string][]string{}}
+	for _, route := range routes {
+		if _, exists := rm.methods[route.Path]; !exists {
+			rm.patterns = append(rm.patterns, route.Path)
+		}
+		if !slices.Contains(rm.methods[route.Path], route.Method) {
+			rm.methods[route.Path] = append(rm.methods[route.Path], route.Method)
+		}
+	}
+	for _, m := range rm.methods {
+		slices.Sort(m)
+	}
+	return rm
+}
+
+// lookup 은 path 에 맞는 모든 경로 패턴의 메소드를 모아 반환합니다.
+func (rm *routeMethods) lookup(path string) []string {
+	var out []string
+	for _, pattern := range rm.patterns {
+		if !matchRoutePattern(pattern, path) {
+			continue
+		}
+		for _, m := range rm.methods[pattern] {
+			if !slices.Contains(out, m) {
+				out = append(out, m)
+			}
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// matchRoutePattern 은 gin 경로 패턴(":param", "*wildcard" 포함)이 path 와 맞는지 확인합니다.
+func matchRoutePattern(pattern, path string) bool {
+	ps := strings.Split(strings.Trim(pattern, "/"), "/")
+	xs := strings.Split(strings.Trim(path, "/"), "/")
+	for i, p := range ps {
+		if strings.HasPrefix(p, "*") {
+			return true
+		}
+		if i >= len(xs) {
+			return false
+		}
+		if strings.HasPrefix(p, ":") {
+			if xs[i] == "" {
+				return false
+			}
+			continue
+		}
+		if p != xs[i] {
+			return false
+		}
+	}
+	return len(ps) == len(xs)
+}
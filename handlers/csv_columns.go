@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// --- CSV 열 매핑 ---
+// 대학알리미/adiga 에서 내려받는 CSV는 해마다 열 순서가 바뀔 수 있으므로, 열 번호 대신 헤더 이름으로 값을 찾습니다.
+// 각 필드는 여러 개의 헤더 이름을 허용하며, 필수 필드가 헤더에 없으면 어떤 열이 없는지 알려주는 에러를 반환합니다.
+
+// CSV 필드 이름 (코드에서 사용하는 논리적 이름)
+const (
+	FieldUniversity          = "university"
+	FieldCampus              = "campus"
+	FieldDepartment          = "department"
+	FieldDepartmentCode      = "departmentCode"
+	FieldDayNight            = "dayNight"
+	FieldRegion              = "region"
+	FieldAdmissionType       = "admissionType"
+	FieldDetailAdmissionType = "detailAdmissionType"
+	FieldCompetitionRate     = "competitionRate"
+	FieldCut50               = "cut50"
+	FieldCut70               = "cut70"
+)
+
+// CSVColumn 은 논리 필드 하나와, 그 필드로 인정할 헤더 이름들입니다.
+type CSVColumn struct {
+	Field    string   `json:"field"`
+	Headers  []string `json:"headers"`
+	Optional bool     `json:"optional,omitempty"`
+}
+
+// CSVColumnSpec 은 CSV 파일 하나의 열 정의입니다.
+type CSVColumnSpec []CSVColumn
+
+// CSVSpecs 는 입시 데이터 로드에 쓰이는 파일별 열 정의입니다.
+type CSVSpecs struct {
+	Departments      CSVColumnSpec `json:"departments"`
+	AdmissionResults CSVColumnSpec `json:"admissionResults"`
+}
+
+// DefaultCSVSpecs 는 현재 사용 중인 대학알리미 학과 정보 / adiga 입시 결과 CSV의 헤더 기준 기본값입니다.
+// 각 필드의 첫 번째 헤더가 그 파일의 실제 헤더이고(testdata/departments.csv, testdata/admission_results.csv 와 같은 형식),
+// 나머지는 해마다 바뀌어 온 이름입니다. 열 위치는 헤더 이름 매핑 전 로더가 쓰던 위치와 같습니다.
+//
+//	학과 정보: 학교명(5) 주야간구분(9) 학과명(11) 학과코드(13)
+//	입시 결과: 대학명(0) 캠퍼스(1) 모집단위(2) 지역(3) 전형유형(4) 전형명(5) 경쟁률(7) 50%컷(9) 70%컷(10)
+var DefaultCSVSpecs = CSVSpecs{
+	Departments: CSVColumnSpec{
+		{Field: FieldUniversity, Headers: []string{"학교명", "대학명"}},
+		{Field: FieldDayNight, Headers: []string{"주야간구분", "주야구분", "주야간"}},
+		{Field: FieldDepartment, Headers: []string{"학과명", "모집단위명", "모집단위"}},
+		{Field: FieldDepartmentCode, Headers: []string{"학과코드", "표준분류코드", "계열코드"}},
+	},
+	AdmissionResults: CSVColumnSpec{
+		{Field: FieldUniversity, Headers: []string{"대학명", "학교명"}},
+		{Field: FieldCampus, Headers: []string{"캠퍼스", "캠퍼스명", "본분교"}, Optional: true},
+		{Field: FieldDepartment, Headers: []string{"모집단위", "모집단위명", "학과명"}},
+		{Field: FieldRegion, Headers: []string{"지역", "시도"}, Optional: true},
+		{Field: FieldAdmissionType, Headers: []string{"전형유형", "전형구분"}},
+		{Field: FieldDetailAdmissionType, Headers: []string{"전형명", "세부전형명", "세부전형"}, Optional: true},
+		{Field: FieldCompetitionRate, Headers: []string{"경쟁률"}, Optional: true},
+		{Field: FieldCut50, Headers: []string{"50%컷", "50%cut", "합격자50%컷", "최종등록자50%컷", "최종등록자50%"}, Optional: true},
+		{Field: FieldCut70, Headers: []string{"70%컷", "70%cut", "합격자70%컷", "최종등록자70%컷", "최종등록자70%"}, Optional: true},
+	},
+}
+
+// LoadCSVColumnSpecs 는 JSON 파일에서 열 정의를 읽어 기본값 위에 덮어씁니다.
+// 파일에 적은 필드만 바뀌고, 적지 않은 필드는 기본값을 그대로 사용합니다.
+//
+//	{ "admissionResults": [ { "field": "cut70", "headers": ["최종등록자 70%"], "optional": true } ] }
+func LoadCSVColumnSpecs(path string) (CSVSpecs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return CSVSpecs{}, err
+	}
+	var override CSVSpecs
+	if err := json.Unmarshal(data, &override); err != nil {
+		return CSVSpecs{}, fmt.Errorf("열 정의 파일 파싱 실패 (%s): %w", path, err)
+	}
+	return CSVSpecs{
+		Departments:      DefaultCSVSpecs.Departments.merge(override.Departments),
+		AdmissionResults: DefaultCSVSpecs.AdmissionResults.merge(override.AdmissionResults),
+	}, nil
+}
+
+func (spec CSVColumnSpec) merge(override CSVColumnSpec) CSVColumnSpec {
+	merged := make(CSVColumnSpec, len(spec))
+	copy(merged, spec)
+	for _, o := range override {
+		replaced := false
+		for i := range merged {
+			if merged[i].Field == o.Field {
+				merged[i] = o
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, o)
+		}
+	}
+	return merged
+}
+
+// csvColumnMap 은 헤더 행을 해석한 결과(필드 -> 열 번호)입니다.
+type csvColumnMap struct {
+	index    map[string]int
+	maxIndex int // 필수 필드 중 가장 큰 열 번호 (행 길이 검사용)
+}
+
+// resolve 는 헤더 행에서 각 필드의 열 번호를 찾습니다.
+// 필수 필드가 하나라도 없으면, 없는 필드와 허용되는 헤더 이름을 모두 담은 에러를 반환합니다.
+func (spec CSVColumnSpec) resolve(header []string) (*csvColumnMap, error) {
+	positions := make(map[string]int, len(header))
+	for i, h := range header {
+		key := normalizeHeader(h)
+		if _, dup := positions[key]; !dup {
+			positions[key] = i
+		}
+	}
+
+	m := &csvColumnMap{index: make(map[string]int), maxIndex: -1}
+	var missing []string
+	for _, col := range spec {
+		found := false
+		for _, h := range col.Headers {
+			if i, ok := positions[normalizeHeader(h)]; ok {
+				m.index[col.Field] = i
+				found = true
+				break
+			}
+		}
+		if !found {
+			if !col.Optional {
+				missing = append(missing, fmt.Sprintf("%s (허용 헤더: %s)", col.Field, strings.Join(col.Headers, ", ")))
+			}
+			continue
+		}
+		if !col.Optional && m.index[col.Field] > m.maxIndex {
+			m.maxIndex = m.index[col.Field]
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("필수 열이 없습니다: %s (헤더 이름이 다르면 data.columnSpecPath 열 정의 파일로 지정하세요)", strings.Join(missing, "; "))
+	}
+	return m, nil
+}
+
+// hasRequired 는 행이 필수 필드를 모두 담을 만큼 긴지 확인합니다.
+func (m *csvColumnMap) hasRequired(record []string) bool {
+	return len(record) > m.maxIndex
+}
+
+// get 은 필드 값을 공백을 제거하여 반환합니다. 열이 없거나 행이 짧으면 빈 문자열입니다.
+func (m *csvColumnMap) get(record []string, field string) string {
+	i, ok := m.index[field]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// normalizeHeader 는 BOM과 모든 공백을 제거하여 헤더를 비교하기 쉽게 만듭니다.
+func normalizeHeader(h string) string {
+	h = strings.TrimPrefix(h, "\ufeff")
+	return strings.Join(strings.Fields(h), "")
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testdata 의 CSV는 대학알리미 학과 정보 / adiga 입시 결과 파일과 같은 헤더(열 순서 포함)를 씁니다.
+const (
+	fixtureDepartmentsCSV = "testdata/departments.csv"
+	fixtureResultsCSV     = "testdata/admission_results.csv"
+)
+
+func TestDefaultCSVSpecsWithRealHeaders(t *testing.T) {
+	dataset := buildAdmissionDataset(DefaultCSVSpecs, nil, fixtureDepartmentsCSV, fixtureResultsCSV)
+	report := dataset.Report
+	require.Empty(t, report.Department.Error)
+	require.Empty(t, report.Admission.Error)
+
+	assert.Equal(t, 4, report.Department.RowsRead)
+	assert.Equal(t, 2, report.Department.RowsUsed)
+	assert.Equal(t, map[string]int{SkipReasonNotDaytime: 1, SkipReasonEmptyName: 1}, report.Department.Skipped)
+
+	assert.Equal(t, 5, report.Admission.RowsRead)
+	assert.Equal(t, map[string]int{SkipReasonUnsupportedAdmission: 1, SkipReasonUnmatchedDepartment: 1}, report.Admission.Skipped)
+	assert.Equal(t, []string{"가천대학교|물리학과"}, report.UnmatchedDepartments)
+	assert.Zero(t, report.UnparseableCount, `"-" 와 빈 칸은 값 없음입니다`)
+
+	require.Len(t, dataset.Records, 3)
+	gyogwa := dataset.Records[0]
+	assert.Equal(t, "가천대학교", gyogwa.UniversityName)
+	assert.Equal(t, "본교(제1캠퍼스)", gyogwa.Campus)
+	assert.Equal(t, "컴퓨터공학과", gyogwa.DepartmentName)
+	assert.Equal(t, "C001", gyogwa.DepartmentCode)
+	assert.Equal(t, "경기", gyogwa.Region)
+	assert.Equal(t, "학생부교과", gyogwa.AdmissionType)
+	assert.Equal(t, "학생부우수자", gyogwa.DetailAdmissionType)
+	// 경쟁률은 모집인원(30)·충원합격순위(41)가 아니라 경쟁률 열에서 읽습니다.
+	assert.Equal(t, ptr(12.5), gyogwa.CompetitionRate)
+	assert.Equal(t, ptr(2.1), gyogwa.Cut50)
+	assert.Equal(t, ptr(2.5), gyogwa.Cut70)
+
+	csat := dataset.Records[1]
+	assert.Equal(t, "수능", csat.AdmissionType)
+	assert.Equal(t, ptr(5.1), csat.CompetitionRate)
+	assert.Nil(t, csat.Cut50)
+	assert.Nil(t, csat.Cut70)
+
+	assert.Equal(t, "B001", dataset.Records[2].DepartmentCode, "야간 학과(B002)는 쓰지 않습니다")
+}
+
+// replaceHeader 는 fixture 파일의 헤더에서 from 열 이름을 to 로 바꾼 복사본을 만듭니다.
+func replaceHeader(t *testing.T, path, from, to string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	header, rest, _ := strings.Cut(string(data), "\n")
+	require.Contains(t, header, from)
+	return writeFile(t, "replaced.csv", strings.Replace(header, from, to, 1)+"\n"+rest)
+}
+
+func TestDefaultCSVSpecsMissingColumn(t *testing.T) {
+	t.Run("학과 정보의 학과코드", func(t *testing.T) {
+		deptPath := replaceHeader(t, fixtureDepartmentsCSV, "학과코드", "학과번호")
+		dataset := buildAdmissionDataset(DefaultCSVSpecs, nil, deptPath, fixtureResultsCSV)
+
+		assert.Contains(t, dataset.Report.Department.Error, "필수 열이 없습니다: departmentCode (허용 헤더: 학과코드, 표준분류코드, 계열코드)")
+		assert.Empty(t, dataset.Records)
+		assert.Error(t, dataset.Report.CheckThresholds(LoadThresholds{MinLoadedRows: 1}))
+	})
+	t.Run("입시 결과의 전형유형", func(t *testing.T) {
+		resultPath := replaceHeader(t, fixtureResultsCSV, "전형유형", "구분")
+		dataset := buildAdmissionDataset(DefaultCSVSpecs, nil, fixtureDepartmentsCSV, resultPath)
+
+		assert.Empty(t, dataset.Report.Department.Error)
+		assert.Contains(t, dataset.Report.Admission.Error, "필수 열이 없습니다: admissionType (허용 헤더: 전형유형, 전형구분)")
+		assert.Zero(t, dataset.Report.Admission.RowsRead)
+		assert.Empty(t, dataset.Records)
+	})
+	t.Run("선택 열은 없어도 됩니다", func(t *testing.T) {
+		resultPath := replaceHeader(t, fixtureResultsCSV, "경쟁률", "비고")
+		dataset := buildAdmissionDataset(DefaultCSVSpecs, nil, fixtureDepartmentsCSV, resultPath)
+
+		assert.Empty(t, dataset.Report.Admission.Error)
+		require.Len(t, dataset.Records, 3)
+		assert.Nil(t, dataset.Records[0].CompetitionRate)
+	})
+}
+
+func TestLoadCSVColumnSpecs(t *testing.T) {
+	specPath := writeFile(t, "columns.json", `{"admissionResults": [{"field": "cut70", "headers": ["최종 70%"], "optional": true}]}`)
+	specs, err := LoadCSVColumnSpecs(specPath)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCSVSpecs.Departments, specs.Departments)
+	assert.Len(t, specs.AdmissionResults, len(DefaultCSVSpecs.AdmissionResults), "같은 필드는 교체합니다")
+
+	resultPath := replaceHeader(t, fixtureResultsCSV, "70%컷", "최종70%")
+	dataset := buildAdmissionDataset(specs, nil, fixtureDepartmentsCSV, resultPath)
+	require.Empty(t, dataset.Report.Admission.Error)
+	require.NotEmpty(t, dataset.Records)
+	assert.Equal(t, ptr(2.5), dataset.Records[0].Cut70)
+
+	_, err = LoadCSVColumnSpecs(writeFile(t, "broken.json", "{"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// --- 교육과정 버전 / 석차등급 체계 ---
+// 과목 목록의 모든 항목(교과구분종류, 교과, 과목)은 교육과정 버전과 적용되는 대입 연도 범위를 가집니다.
+// 2022 개정 교육과정(2028학년도 대입~)의 내신은 5등급제, 그 이전은 9등급제입니다. 수능은 계속 9등급제입니다.
+
+// 교육과정 버전
+const (
+	CurriculumVersion2009 = "2009"
+	CurriculumVersion2015 = "2015"
+	CurriculumVersion2022 = "2022"
+
+	// DefaultCurriculumVersion 은 버전을 지정하지 않은 요청과 과목 목록 항목에 쓰는 버전입니다.
+	DefaultCurriculumVersion = CurriculumVersion2015
+)
+
+// curriculumVersions 는 알려진 교육과정 버전입니다.
+var curriculumVersions = []string{CurriculumVersion2009, CurriculumVersion2015, CurriculumVersion2022}
+
+// compatibleCurriculumVersions 는 성적 입력 버전별로 함께 쓸 수 있는 과목의 버전입니다.
+// 2015 개정 과목 목록에는 예전부터 2009 개정 과목명(미적분Ⅰ, 기하와 벡터 등)이 섞여 있어 함께 허용합니다.
+var compatibleCurriculumVersions = map[string][]string{
+	CurriculumVersion2009: {CurriculumVersion2009},
+	CurriculumVersion2015: {CurriculumVersion2015, CurriculumVersion2009},
+	CurriculumVersion2022: {CurriculumVersion2022},
+}
+
+// 석차등급 체계
+const (
+	RankScale9 = 9
+	RankScale5 = 5
+)
+
+// rankScaleCumulativePercent 는 등급 체계별 등급 하한의 누적 비율(%)입니다. (예: 9등급제 1등급은 상위 4%)
+var rankScaleCumulativePercent = map[int][]float64{
+	RankScale9: {4, 11, 23, 40, 60, 77, 89, 96, 100},
+	RankScale5: {10, 34, 66, 90, 100},
+}
+
+// IsCurriculumVersion 은 알려진 교육과정 버전인지 확인합니다.
+func IsCurriculumVersion(version string) bool {
+	return slices.Contains(curriculumVersions, version)
+}
+
+// RankScaleForCurriculum 은 교육과정 버전의 내신 석차등급 체계(5 또는 9)입니다.
+func RankScaleForCurriculum(version string) int {
+	if version == CurriculumVersion2022 {
+		return RankScale5
+	}
+	return RankScale9
+}
+
+// ConvertRank 는 from 등급제의 (평균)등급을 누적 비율이 같은 to 등급제의 등급으로 바꿉니다.
+// 각 등급을 그 등급 구간의 중간 비율에 대응시키고, 사이 값은 선형 보간합니다.
+// 예: 5등급제 1등급(상위 0~10%, 중간 5%)은 9등급제 약 1.5등급입니다.
+func ConvertRank(rank float64, from, to int) (float64, error) {
+	if from == to {
+		return rank, nil
+	}
+	fromCum, ok := rankScaleCumulativePercent[from]
+	if !ok {
+		return 0, fmt.Errorf("지원하지 않는 석차등급 체계입니다: %d", from)
+	}
+	toCum, ok := rankScaleCumulativePercent[to]
+	if !ok {
+		return 0, fmt.Errorf("지원하지 않는 석차등급 체계입니다: %d", to)
+	}
+	return percentileToRank(rankToPercentile(rank, fromCum), toCum), nil
+}
+
+// rankMidpoints 는 등급별 구간 중간의 누적 비율입니다.
+func rankMidpoints(cum []float64) []float64 {
+	mids := make([]float64, len(cum))
+	lower := 0.0
+	for i, upper := range cum {
+		mids[i] = (lower + upper) / 2
+		lower = upper
+	}
+	return mids
+}
+
+// rankToPercentile 은 등급(1부터, 소수 가능)을 누적 비율로 바꿉니다. 범위를 벗어난 등급은 양 끝 등급으로 봅니다.
+func rankToPercentile(rank float64, cum []float64) float64 {
+	mids := rankMidpoints(cum)
+	pos := math.Min(math.Max(rank, 1), float64(len(mids))) - 1
+	i := int(pos)
+	if i >= len(mids)-1 {
+		return mids[len(mids)-1]
+	}
+	frac := pos - float64(i)
+	return mids[i] + frac*(mids[i+1]-mids[i])
+}
+
+// percentileToRank 는 누적 비율을 등급(소수 가능)으로 바꿉니다.
+func percentileToRank(p float64, cum []float64) float64 {
+	mids := rankMidpoints(cum)
+	if p <= mids[0] {
+		return 1
+	}
+	for i := 1; i < len(mids); i++ {
+		if p <= mids[i] {
+			return float64(i) + (p-mids[i-1])/(mids[i]-mids[i-1])
+		}
+	}
+	return float64(len(mids))
+}
+
+// rankPercentile 은 scale 등급제의 정수 등급을 누적 비율로 바꿉니다. 등급제가 섞여 있어도 같은 기준으로 비교하기 위해 씁니다.
+func rankPercentile(rank, scale int) float64 {
+	cum, ok := rankScaleCumulativePercent[scale]
+	if !ok {
+		cum = rankScaleCumulativePercent[RankScale9]
+	}
+	return rankToPercentile(float64(rank), cum)
+}
+
+// CurriculumApplicability 는 과목 목록 항목이 속한 교육과정 버전과 적용되는 대입 연도 범위입니다.
+// 연도 범위의 양 끝은 비어 있으면 제한이 없습니다.
+type CurriculumApplicability struct {
+	CurriculumVersion string `json:"curriculumVersion"`           // "2009" | "2015" | "2022"
+	AdmissionYearFrom *int   `json:"admissionYearFrom,omitempty"` // 처음 적용되는 대입 학년도
+	AdmissionYearTo   *int   `json:"admissionYearTo,omitempty"`   // 마지막으로 적용되는 대입 학년도
+}
+
+// appliesTo 는 항목이 version(비어 있으면 모든 버전)과 admissionYear(0 이면 모든 연도)에 해당하는지 확인합니다.
+func (a CurriculumApplicability) appliesTo(version string, admissionYear int) bool {
+	if version != "" && a.CurriculumVersion != version {
+		return false
+	}
+	if admissionYear != 0 {
+		if a.AdmissionYearFrom != nil && admissionYear < *a.AdmissionYearFrom {
+			return false
+		}
+		if a.AdmissionYearTo != nil && admissionYear > *a.AdmissionYearTo {
+			return false
+		}
+	}
+	return true
+}
+
+// withDefaultVersion 은 버전이 비어 있으면 DefaultCurriculumVersion 으로 채운 값을 반환합니다. (버전 도입 전의 과목 목록 파일 호환)
+func (a CurriculumApplicability) withDefaultVersion() CurriculumApplicability {
+	if a.CurriculumVersion == "" {
+		a.CurriculumVersion = DefaultCurriculumVersion
+	}
+	return a
+}
+
+// validate 는 버전과 연도 범위를 확인합니다. 문제가 있으면 설명을 반환합니다.
+func (a CurriculumApplicability) validate() string {
+	if !IsCurriculumVersion(a.CurriculumVersion) {
+		return fmt.Sprintf("curriculumVersion 은 %v 중 하나여야 합니다: %q", curriculumVersions, a.CurriculumVersion)
+	}
+	if a.AdmissionYearFrom != nil && a.AdmissionYearTo != nil && *a.AdmissionYearFrom > *a.AdmissionYearTo {
+		return fmt.Sprintf("admissionYearFrom 이 admissionYearTo 보다 큽니다: %d > %d", *a.AdmissionYearFrom, *a.AdmissionYearTo)
+	}
+	return ""
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- 입시 데이터 스냅샷 ---
+// 입시 데이터는 버전이 붙은 불변 스냅샷으로 관리하고, 다시 로드할 때는 새 스냅샷을 만든 뒤 포인터만 교체합니다.
+// 스냅샷과 원본 파일 경로는 Server 마다 따로 가집니다.
+// 핸들러는 요청 시작 시점에 CurrentDataset() 을 한 번 읽어 끝까지 사용하므로,
+// 처리 중인 요청은 교체 전 스냅샷으로 안전하게 마무리됩니다.
+
+// AdmissionDataset 은 한 번의 로드로 만들어진 입시 데이터 스냅샷입니다. 만든 뒤에는 수정하지 않습니다.
+type AdmissionDataset struct {
+	Version   int64
+	LoadedAt  time.Time
+	Records   []AdmissionResult
+	Locations map[string]Location // 대학명 -> 위치
+	Report    *AdmissionLoadReport
+}
+
+// 아직 아무것도 로드하지 않았을 때 반환하는 빈 스냅샷
+var emptyDataset = &AdmissionDataset{Locations: map[string]Location{}}
+
+// CurrentDataset 은 현재 서비스 중인 입시 데이터 스냅샷을 반환합니다. (nil 을 반환하지 않음)
+func (s *Server) CurrentDataset() *AdmissionDataset {
+	if ds := s.dataset.Load(); ds != nil {
+		return ds
+	}
+	return emptyDataset
+}
+
+// LoadAdmissionData 는 입시 데이터를 처음 로드하여 서비스에 반영하고 로드 리포트를 반환합니다.
+// 파일 경로는 기억해 두었다가 ReloadAdmissionData 에서 다시 사용합니다.
+func (s *Server) LoadAdmissionData(departmentInfoPath, admissionResultPath string) *AdmissionLoadReport {
+	s.dataSourceMu.Lock()
+	defer s.dataSourceMu.Unlock()
+
+	s.departmentInfoFile = departmentInfoPath
+	s.admissionResultFile = admissionResultPath
+	return s.publishDataset(s.buildDataset(departmentInfoPath, admissionResultPath)).Report
+}
+
+// LoadRecords 는 파일 대신 주어진 입시 결과로 스냅샷을 만들어 반영합니다. (테스트 fixture 용)
+// 대학 위치는 각 결과의 Location 에서 모읍니다.
+func (s *Server) LoadRecords(records []AdmissionResult) *AdmissionDataset {
+	dataset := &AdmissionDataset{Records: records, Locations: map[string]Location{}}
+	for i := range dataset.Records {
+		rec := &dataset.Records[i]
+		rec.assignIDs()
+		if rec.Location != nil {
+			dataset.Locations[rec.UniversityName] = *rec.Location
+		}
+	}
+	return s.publishDataset(dataset)
+}
+
+// SetLoadThresholds 는 다시 로드할 때 적용할 기준값을 설정합니다.
+// 새 데이터가 기준을 넘으면 교체하지 않고 기존 스냅샷을 유지합니다.
+func (s *Server) SetLoadThresholds(t LoadThresholds) {
+	s.dataSourceMu.Lock()
+	defer s.dataSourceMu.Unlock()
+	s.reloadThresholds = t
+}
+
+// ReloadAdmissionData 는 처음 로드했던 파일을 다시 읽어 새 스냅샷을 만들고, 기준을 통과하면 교체합니다.
+// 기준을 통과하지 못하면 에러와 함께 (반영되지 않은) 새 데이터셋을 반환합니다.
+func (s *Server) ReloadAdmissionData() (*AdmissionDataset, error) {
+	s.dataSourceMu.Lock()
+	defer s.dataSourceMu.Unlock()
+
+	if s.departmentInfoFile == "" || s.admissionResultFile == "" {
+		return nil, fmt.Errorf("입시 데이터 파일 경로가 설정되지 않았습니다")
+	}
+	dataset := s.buildDataset(s.departmentInfoFile, s.admissionResultFile)
+	if err := dataset.Report.CheckThresholds(s.reloadThresholds); err != nil {
+		return dataset, err
+	}
+	return s.publishDataset(dataset), nil
+}
+
+// buildDataset 은 서버의 CSV 열 정의와 대학 위치로 새 스냅샷을 만들고, 로드 리포트를 최근 리포트로 기록합니다.
+func (s *Server) buildDataset(departmentInfoPath, admissionResultPath string) *AdmissionDataset {
+	var universities UniversityRepository
+	if s.store != nil {
+		universities = s.store.Universities
+	}
+	dataset := buildAdmissionDataset(s.csvSpecs, universities, departmentInfoPath, admissionResultPath)
+	s.setLastLoadReport(dataset.Report)
+	return dataset
+}
+
+func (s *Server) publishDataset(dataset *AdmissionDataset) *AdmissionDataset {
+	dataset.Version = s.datasetVersion.Add(1)
+	dataset.LoadedAt = time.Now()
+	s.dataset.Store(dataset)
+	s.logger.Info("입시 데이터 스냅샷 반영", "version", dataset.Version, "records", len(dataset.Records))
+	return dataset
+}
+
+// WatchAdmissionData 는 interval 마다 입시 데이터 파일의 수정 시각을 확인하고, 바뀌었으면 다시 로드합니다.
+// ctx 가 취소될 때까지 실행되므로 고루틴으로 호출해야 합니다.
+func (s *Server) WatchAdmissionData(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastModified := s.dataFilesModTime()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			modified := s.dataFilesModTime()
+			if modified.Equal(lastModified) {
+				continue
+			}
+			lastModified = modified
+			s.logger.Info("입시 데이터 파일 변경 감지, 다시 로드합니다")
+			if _, err := s.ReloadAdmissionData(); err != nil {
+				s.logger.Error("입시 데이터 다시 로드 실패 (기존 스냅샷 유지)", "error", err)
+			}
+		}
+	}
+}
+
+// dataFilesModTime 은 입시 데이터 파일들 중 가장 최근 수정 시각을 반환합니다.
+func (s *Server) dataFilesModTime() time.Time {
+	s.dataSourceMu.Lock()
+	paths := []string{s.departmentInfoFile, s.admissionResultFile}
+	s.dataSourceMu.Unlock()
+
+	var latest time.Time
+	for _, path := range paths {
+		if info, err := os.Stat(path); err == nil && info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest
+}
+
+// ReloadDataHandler 는 입시 데이터를 다시 로드합니다. (관리자용)
+// POST /api/admin/reload-data
+func (s *Server) ReloadDataHandler(c *gin.Context) {
+	dataset, err := s.ReloadAdmissionData()
+	if err != nil {
+		details := gin.H{"reason": err.Error()}
+		if dataset != nil {
+			details["report"] = dataset.Report
+		}
+		abortWithError(c, http.StatusUnprocessableEntity, ErrCodeDataLoadFailed,
+			"입시 데이터를 다시 로드하지 못했습니다. 기존 데이터를 계속 사용합니다.", details)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"version":  dataset.Version,
+		"loadedAt": dataset.LoadedAt,
+		"records":  len(dataset.Records),
+		"report":   dataset.Report,
+	})
+}
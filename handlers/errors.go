@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- 공통 에러 응답 ---
+// 모든 API 에러는 같은 형식으로 응답합니다. code 는 클라이언트가 분기에 쓰는 고정 문자열이고,
+// message 는 사용자에게 보여줄 수 있는 한국어 설명입니다.
+//
+//	{ "error": { "code": "INVALID_PARAMETER", "message": "유효하지 않은 type 값입니다.", "details": { ... } } }
+
+// 에러 코드
+const (
+	ErrCodeInvalidParameter = "INVALID_PARAMETER"  // 쿼리/경로 파라미터가 없거나 올바르지 않음
+	ErrCodeInvalidPayload   = "INVALID_PAYLOAD"    // 요청 본문(JSON)이 올바르지 않음
+	ErrCodeValidationFailed = "VALIDATION_FAILED"  // 요청 본문의 값이 허용 범위/목록을 벗어남 (details.fields)
+	ErrCodeUnauthorized     = "UNAUTHORIZED"       // 인증 필요
+	ErrCodeForbidden        = "FORBIDDEN"          // 허용되지 않은 요청 (예: CORS)
+	ErrCodeNotFound         = "NOT_FOUND"          // 대상 리소스 없음
+	ErrCodeRouteNotFound    = "ROUTE_NOT_FOUND"    // 등록되지 않은 경로
+	ErrCodeMethodNotAllowed = "METHOD_NOT_ALLOWED" // 경로는 있지만 메소드가 맞지 않음
+	ErrCodeDataNotLoaded    = "DATA_NOT_LOADED"    // 아직 데이터가 로드되지 않음
+	ErrCodeDataLoadFailed   = "DATA_LOAD_FAILED"   // 데이터 로드/검사 실패
+	ErrCodeInternal         = "INTERNAL_ERROR"     // 서버 내부 오류
+)
+
+// APIError 는 에러 응답의 본문입니다.
+type APIError struct {
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// ErrorResponse 는 에러 응답 전체입니다.
+type ErrorResponse struct {
+	Error APIError `json:"error"`
+}
+
+// abortWithError 는 공통 형식의 에러를 응답하고 이후 핸들러 실행을 중단합니다.
+func abortWithError(c *gin.Context, status int, code, message string, details interface{}) {
+	c.AbortWithStatusJSON(status, ErrorResponse{Error: APIError{Code: code, Message: message, Details: details}})
+}
+
+// abortWithInternalError 는 내부 오류를 로그에 남기고, 클라이언트에는 원인을 노출하지 않고 500 을 응답합니다.
+func abortWithInternalError(c *gin.Context, message string, err error) {
+	LoggerFrom(c.Request.Context()).Error(message, "method", c.Request.Method, "path", c.Request.URL.Path, "error", err)
+	abortWithError(c, http.StatusInternalServerError, ErrCodeInternal, message, nil)
+}
+
+// routeNotFound 는 등록되지 않은 경로에 대한 404 응답입니다.
+func routeNotFound(c *gin.Context) {
+	abortWithError(c, http.StatusNotFound, ErrCodeRouteNotFound, "요청한 경로를 찾을 수 없습니다.", gin.H{"path": c.Request.URL.Path})
+}
+
+// methodNotAllowed 는 경로는 있지만 메소드가 등록되지 않은 요청에 대한 405 응답입니다.
+func methodNotAllowed(c *gin.Context) {
+	abortWithError(c, http.StatusMethodNotAllowed, ErrCodeMethodNotAllowed, "허용되지 않은 메소드입니다.",
+		gin.H{"method": c.Request.Method, "path": c.Request.URL.Path})
+}
+
+// basicAuth 는 관리자 계정으로 HTTP Basic 인증을 요구하는 미들웨어입니다. 실패하면 공통 형식의 401 을 응답합니다.
+func basicAuth(username, password string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, pass, ok := c.Request.BasicAuth()
+		if !ok ||
+			subtle.ConstantTimeCompare([]byte(user), []byte(username)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(pass), []byte(password)) != 1 {
+			c.Header("WWW-Authenticate", `Basic realm="univ admin", charset="UTF-8"`)
+			abortWithError(c, http.StatusUnauthorized, ErrCodeUnauthorized, "관리자 인증이 필요합니다.", nil)
+			return
+		}
+		c.Next()
+	}
+}
+
+// adminDisabled 는 관리자 계정이 설정되지 않았을 때 /api/admin 의 모든 요청을 403 으로 거절하는 미들웨어입니다.
+func adminDisabled(c *gin.Context) {
+	abortWithError(c, http.StatusForbidden, ErrCodeForbidden,
+		"관리자 계정이 설정되지 않아 관리자 API를 사용할 수 없습니다. (UNIV_ADMIN_USER / UNIV_ADMIN_PASSWORD)", nil)
+}
@@ -3,15 +3,16 @@
 package handlers
 
 import (
-	"database/sql"
+	"context"
 	"encoding/csv"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
-	"sync"
 
 	"github.com/gin-gonic/gin"
 )
@@ -41,16 +42,37 @@ type NaesinSubject struct {
 type NaesinGrades map[string][]NaesinSubject
 
 type SuneungGrades struct {
-	ExamYear                 int         `json:"examYear"`
-	ExamMonth                int         `json:"examMonth"`
-	ExamIdentifierForCutInfo string      `json:"examIdentifierForCutInfo"`
-	Subjects                 interface{} `json:"subjects"`
+	ExamYear                 int    `json:"examYear"`
+	ExamMonth                int    `json:"examMonth"`
+	ExamIdentifierForCutInfo string `json:"examIdentifierForCutInfo"`
+	// Subjects 는 영역별 성적입니다. 키는 수능 영역의 payloadKey (korean, math, english, history, explorer1, explorer2 …, foreignLanguage)
+	Subjects map[string]SuneungSubjectScore `json:"subjects"`
+}
+
+// SuneungSubjectScore 는 수능 성적의 과목 하나입니다. (ApiSuneungSubjectPayload)
+type SuneungSubjectScore struct {
+	RawScore       *float64 `json:"rawScore"`
+	SelectedOption *string  `json:"selectedOption,omitempty"` // 국어, 수학의 선택과목명
+	SubjectName    *string  `json:"subjectName,omitempty"`    // 탐구, 제2외국어/한문 과목명
+}
+
+// subjectName 은 selectedOption 또는 subjectName 중 입력한 과목명을 반환합니다.
+func (s SuneungSubjectScore) subjectName() string {
+	for _, name := range []*string{s.SelectedOption, s.SubjectName} {
+		if name != nil && strings.TrimSpace(*name) != "" {
+			return strings.TrimSpace(*name)
+		}
+	}
+	return ""
 }
 
 type FilterPayload struct {
 	UserGrades struct {
-		Naesin  NaesinGrades  `json:"naesin"`
-		Suneung SuneungGrades `json:"suneung"`
+		// CurriculumVersion 은 내신 성적의 교육과정 버전입니다. ("2009" | "2015" | "2022", 비우면 "2015")
+		// 2022 개정 교육과정은 5등급제이므로 등급 범위와 평균 등급 환산이 달라집니다.
+		CurriculumVersion string        `json:"curriculumVersion"`
+		Naesin            NaesinGrades  `json:"naesin"`
+		Suneung           SuneungGrades `json:"suneung"`
 	} `json:"userGrades"`
 	FilterCriteria struct {
 		DepartmentKeywords       string  `json:"departmentKeywords"`
@@ -62,6 +84,9 @@ type FilterPayload struct {
 type FilteredUniversity struct {
 	UniversityID           string               `json:"universityId"`
 	UniversityName         string               `json:"universityName"`
+	CampusID               string               `json:"campusId"`
+	DepartmentID           string               `json:"departmentId"`
+	AdmissionProgramID     string               `json:"admissionProgramId,omitempty"`
 	Location               Location             `json:"location"`
 	DepartmentName         string               `json:"departmentName"`
 	AdmissionTypeResults   AdmissionTypeResults `json:"admissionTypeResults"`
@@ -90,6 +115,12 @@ type AdmissionTypeSpecificResults struct {
 // --- CSV 및 DB 데이터 처리를 위한 구조체 및 변수 ---
 
 type AdmissionResult struct {
+	// 안정적인 식별자 (ids.go 참고). 데이터 로드 시점에 한 번 계산해 둡니다.
+	UniversityID       string
+	CampusID           string
+	DepartmentID       string
+	AdmissionProgramID string
+
 	UniversityName  string
 	Campus          string
 	DepartmentName  string
@@ -101,153 +132,217 @@ type AdmissionResult struct {
 	Cut70           *float64
 	// 세부 전형명 추가
 	DetailAdmissionType string
+	// 대학 위치 (universities 테이블에 좌표가 있는 경우)
+	Location *Location
 }
 
-var universityLocations = make(map[string]Location)
-var (
-	admissionData []AdmissionResult
-	once          sync.Once
-)
+// assignIDs 는 이름 필드로부터 대학/캠퍼스/학과/전형 ID를 채웁니다.
+func (r *AdmissionResult) assignIDs() {
+	campus := strings.TrimSpace(r.Campus)
+	r.UniversityID = UniversityID(r.UniversityName)
+	r.CampusID = CampusID(r.UniversityName, campus)
+	r.DepartmentID = DepartmentID(r.UniversityName, campus, r.DepartmentName)
+	r.AdmissionProgramID = AdmissionProgramID(r.UniversityName, campus, r.DepartmentName, r.AdmissionType, r.DetailAdmissionType)
+}
 
-// LoadAdmissionData (디버깅 로그 추가)
-func LoadAdmissionData(departmentInfoPath, admissionResultPath string) {
-	once.Do(func() {
-		// --- 0단계: DB 위치 정보 로드 ---
-		if db == nil {
-			return
-		}
-		rows, err := db.Query("SELECT name, latitude, longitude FROM universities")
-		if err != nil {
-			return
-		}
-		defer rows.Close()
+// 입시 결과 CSV에서 숫자로 읽는 필드 (필드, 리포트용 이름)
+var admissionNumericColumns = []struct {
+	field string
+	name  string
+}{
+	{FieldCompetitionRate, "경쟁률"},
+	{FieldCut50, "50%컷"},
+	{FieldCut70, "70%컷"},
+}
 
-		for rows.Next() {
-			var name string
-			var lat, lon sql.NullFloat64
-			if err := rows.Scan(&name, &lat, &lon); err != nil {
-				continue
-			}
-			if lat.Valid && lon.Valid {
-				universityLocations[name] = Location{
-					Latitude:  lat.Float64,
-					Longitude: lon.Float64,
-				}
-			}
+// buildAdmissionDataset 은 DB의 대학 위치와 두 CSV 파일을 읽어 새 데이터셋을 만듭니다.
+// 전역 상태를 건드리지 않으므로, 만든 데이터셋을 서비스에 반영할지는 호출하는 쪽에서 결정합니다.
+func buildAdmissionDataset(specs CSVSpecs, universities UniversityRepository, departmentInfoPath, admissionResultPath string) *AdmissionDataset {
+	dataset := &AdmissionDataset{
+		Records:   []AdmissionResult{},
+		Locations: make(map[string]Location),
+	}
+	report := newAdmissionLoadReport(departmentInfoPath, admissionResultPath)
+	dataset.Report = report
+	defer report.finish()
+
+	// --- 0단계: DB 위치 정보 로드 ---
+	if universities != nil {
+		if locations, err := universityLocations(universities); err != nil {
+			slog.Warn("대학 위치 정보 조회 에러", "error", err)
+		} else {
+			dataset.Locations = locations
 		}
+	}
+	report.Locations = len(dataset.Locations)
 
-		// --- 1단계: 학과 정보 CSV 로드하여 맵 생성 ---
-		deptCodeMap := make(map[string]string)
+	// --- 1단계: 학과 정보 CSV 로드하여 맵 생성 ---
+	deptCodeMap := make(map[string]string)
+	deptReport := &report.Department
 
-		deptFile, err := os.Open(departmentInfoPath)
+	deptFile, err := os.Open(departmentInfoPath)
+	if err != nil {
+		deptReport.Error = err.Error()
+		return dataset
+	}
+	defer deptFile.Close()
+
+	deptReader := csv.NewReader(deptFile)
+	deptReader.FieldsPerRecord = -1
+	deptHeader, err := deptReader.Read()
+	if err != nil {
+		deptReport.Error = fmt.Sprintf("헤더 읽기 실패: %v", err)
+		return dataset
+	}
+	deptColumns, err := specs.Departments.resolve(deptHeader)
+	if err != nil {
+		deptReport.Error = err.Error()
+		return dataset
+	}
+
+	lineNum := 1
+	for {
+		lineNum++
+		record, err := deptReader.Read()
+		if err == io.EOF {
+			break
+		}
+		deptReport.RowsRead++
 		if err != nil {
-			return
+			deptReport.skip(SkipReasonReadError, lineNum)
+			continue
+		}
+		if !deptColumns.hasRequired(record) {
+			deptReport.skip(SkipReasonShortRow, lineNum)
+			continue
 		}
-		defer deptFile.Close()
 
-		deptReader := csv.NewReader(deptFile)
-		deptReader.FieldsPerRecord = -1
-		if _, err := deptReader.Read(); err != nil { // 헤더 스킵
-			return
+		if deptColumns.get(record, FieldDayNight) != "주간" {
+			deptReport.skip(SkipReasonNotDaytime, lineNum)
+			continue
 		}
 
-		lineNum := 1
-		for {
-			lineNum++
-			record, err := deptReader.Read()
-			if err == io.EOF {
-				break
-			}
-			if err != nil {
-				continue
-			}
+		uniName := deptColumns.get(record, FieldUniversity)
+		deptName := deptColumns.get(record, FieldDepartment)
+		deptCode := deptColumns.get(record, FieldDepartmentCode)
 
-			if record[9] != "주간" {
-				continue
-			}
+		if uniName == "" || deptName == "" {
+			deptReport.skip(SkipReasonEmptyName, lineNum)
+			continue
+		}
 
-			uniName := strings.TrimSpace(record[5])
-			deptName := strings.TrimSpace(record[11])
-			deptCode := strings.TrimSpace(record[13])
+		mapKey := fmt.Sprintf("%s|%s", uniName, deptName)
+		deptCodeMap[mapKey] = deptCode
+		deptReport.RowsUsed++
+	}
 
-			if uniName == "" || deptName == "" {
-				continue
-			}
+	// --- 2단계: 입시 결과 CSV 로드 및 학과 코드 결합 ---
+	resultReport := &report.Admission
 
-			mapKey := fmt.Sprintf("%s|%s", uniName, deptName)
-			deptCodeMap[mapKey] = deptCode
-		}
+	resultFile, err := os.Open(admissionResultPath)
+	if err != nil {
+		resultReport.Error = err.Error()
+		return dataset
+	}
+	defer resultFile.Close()
+
+	resultReader := csv.NewReader(resultFile)
+	resultReader.FieldsPerRecord = -1
+	resultHeader, err := resultReader.Read()
+	if err != nil {
+		resultReport.Error = fmt.Sprintf("헤더 읽기 실패: %v", err)
+		return dataset
+	}
+	resultColumns, err := specs.AdmissionResults.resolve(resultHeader)
+	if err != nil {
+		resultReport.Error = err.Error()
+		return dataset
+	}
 
-		// --- 2단계: 입시 결과 CSV 로드 및 학과 코드 결합 ---
-		resultFile, err := os.Open(admissionResultPath)
+	lineNum = 1
+	for {
+		lineNum++
+		record, err := resultReader.Read()
+		if err == io.EOF {
+			break
+		}
+		resultReport.RowsRead++
 		if err != nil {
-			return
+			resultReport.skip(SkipReasonReadError, lineNum)
+			continue
 		}
-		defer resultFile.Close()
-
-		resultReader := csv.NewReader(resultFile)
-		resultReader.FieldsPerRecord = -1
-		if _, err := resultReader.Read(); err != nil { // 헤더 스킵
-			return
+		if !resultColumns.hasRequired(record) {
+			resultReport.skip(SkipReasonShortRow, lineNum)
+			continue
 		}
 
-		lineNum = 1
-		for {
-			lineNum++
-			record, err := resultReader.Read()
-			if err == io.EOF {
-				break
-			}
-			if err != nil {
-				continue
-			}
-
-			uniName := strings.TrimSpace(record[0])
-			deptName := strings.TrimSpace(record[2])
+		uniName := resultColumns.get(record, FieldUniversity)
+		deptName := resultColumns.get(record, FieldDepartment)
 
-			mapKey := fmt.Sprintf("%s|%s", uniName, deptName)
-			deptCode, ok := deptCodeMap[mapKey]
+		mapKey := fmt.Sprintf("%s|%s", uniName, deptName)
+		deptCode, ok := deptCodeMap[mapKey]
 
-			if !ok {
-				continue
-			}
+		if !ok {
+			resultReport.skip(SkipReasonUnmatchedDepartment, lineNum)
+			report.addUnmatched(mapKey)
+			continue
+		}
 
-			admissionType := record[4]
-			if !(strings.Contains(admissionType, "수능") || strings.Contains(admissionType, "교과") || strings.Contains(admissionType, "종합")) {
-				continue
-			}
+		admissionType := resultColumns.get(record, FieldAdmissionType)
+		if !(strings.Contains(admissionType, "수능") || strings.Contains(admissionType, "교과") || strings.Contains(admissionType, "종합")) {
+			resultReport.skip(SkipReasonUnsupportedAdmission, lineNum)
+			continue
+		}
 
-			data := AdmissionResult{
-				UniversityName: uniName,
-				Campus:         record[1],
-				DepartmentName: deptName,
-				DepartmentCode: deptCode,
-				Region:         record[3],
-				AdmissionType:  admissionType,
-			}
+		data := AdmissionResult{
+			UniversityName: uniName,
+			Campus:         resultColumns.get(record, FieldCampus),
+			DepartmentName: deptName,
+			DepartmentCode: deptCode,
+			Region:         resultColumns.get(record, FieldRegion),
+			AdmissionType:  admissionType,
+		}
 
-			if val, err := strconv.ParseFloat(record[7], 64); err == nil {
-				data.CompetitionRate = &val
-			}
-			if val, err := strconv.ParseFloat(record[9], 64); err == nil {
-				data.Cut50 = &val
+		targets := []**float64{&data.CompetitionRate, &data.Cut50, &data.Cut70}
+		for i, col := range admissionNumericColumns {
+			raw := resultColumns.get(record, col.field)
+			if raw == "" || raw == "-" {
+				continue // 값 없음은 오류로 보지 않습니다.
 			}
-			if val, err := strconv.ParseFloat(record[10], 64); err == nil {
-				data.Cut70 = &val
+			if val, err := strconv.ParseFloat(raw, 64); err == nil {
+				*targets[i] = &val
+			} else {
+				report.addUnparseable(lineNum, col.name, raw)
 			}
-			// 세부 전형명 필드 추가
-			data.DetailAdmissionType = strings.TrimSpace(record[5])
-
-			admissionData = append(admissionData, data)
 		}
-	})
+		// 세부 전형명 필드 추가
+		data.DetailAdmissionType = resultColumns.get(record, FieldDetailAdmissionType)
+		data.assignIDs()
+		if loc, found := dataset.Locations[uniName]; found {
+			data.Location = &loc
+		}
+
+		dataset.Records = append(dataset.Records, data)
+		resultReport.RowsUsed++
+	}
+	report.Loaded = len(dataset.Records)
+	return dataset
 }
 
 // FilterUniversities 핸들러 (디버깅 로그 추가)
-func FilterUniversities(c *gin.Context) {
+func (s *Server) FilterUniversities(c *gin.Context) {
 	var payload FilterPayload
 	if err := c.ShouldBindJSON(&payload); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request payload", "details": err.Error()})
+		abortWithError(c, http.StatusBadRequest, ErrCodeInvalidPayload, "요청 본문이 올바르지 않습니다.", gin.H{"reason": err.Error()})
+		return
+	}
+	catalog, err := s.SubjectCatalog()
+	if err != nil {
+		abortWithInternalError(c, "과목 목록 조회 중 에러가 발생했습니다.", err)
+		return
+	}
+	if fields := payload.Validate(catalog); fields != nil {
+		abortWithError(c, http.StatusBadRequest, ErrCodeValidationFailed, "입력한 성적 값이 올바르지 않습니다.", gin.H{"fields": fields})
 		return
 	}
 
@@ -268,21 +363,48 @@ func FilterUniversities(c *gin.Context) {
 	}
 	if totalCredits > 0 {
 		calculatedScore := totalGradeCredits / totalCredits
+		// 입시 결과(등급컷)는 9등급제 기준이므로, 5등급제(2022 개정) 평균 등급은 9등급제로 환산해 비교합니다.
+		if scale := RankScaleForCurriculum(payload.UserGrades.CurriculumVersion); scale != RankScale9 {
+			converted, err := ConvertRank(calculatedScore, scale, RankScale9)
+			if err != nil {
+				abortWithInternalError(c, "내신 등급 환산 중 에러가 발생했습니다.", err)
+				return
+			}
+			calculatedScore = converted
+		}
 		userCalculatedScore = &calculatedScore
 	}
 
 	scoreDifferenceTolerance := float64(payload.FilterCriteria.ScoreDifferenceTolerance)
+	schemeScorer := &gpaSchemeScorer{
+		server:  s,
+		ctx:     c.Request.Context(),
+		catalog: catalog,
+		naesin:  payload.UserGrades.Naesin,
+		version: payload.UserGrades.CurriculumVersion,
+		scores:  map[string][]GpaScore{},
+	}
 
 	finalResults := make([]FilteredUniversity, 0)
 
-	for _, record := range admissionData {
-		// --- 세부전형명(AdmissionType) 필터링 ---
-		if admissionTypeKeyword != "경쟁률" && admissionTypeKeyword != "" && !strings.Contains(record.AdmissionType, admissionTypeKeyword) {
-			continue
-		}
-		if deptCodeKeywords != "" && record.DepartmentCode != deptCodeKeywords {
-			continue
+	// --- 학과 코드/세부전형명(AdmissionType) 조건으로 입시 결과 조회 ---
+	query := AdmissionQuery{DepartmentCode: deptCodeKeywords}
+	if admissionTypeKeyword != "경쟁률" {
+		query.AdmissionTypeContains = admissionTypeKeyword
+	}
+	records, err := s.admissions.FindAdmissions(query)
+	if err != nil {
+		abortWithInternalError(c, "입시 결과 조회 중 에러가 발생했습니다.", err)
+		return
+	}
+	if admissionTypeKeyword != "경쟁률" {
+		if err := schemeScorer.loadSchemes(records); err != nil {
+			abortWithInternalError(c, "계산 스키마 조회 중 에러가 발생했습니다.", err)
+			return
 		}
+	}
+
+	for _, record := range records {
 
 		// 경쟁률 전형 필터일 때: 성적 기반 필터링 없이 경쟁률 정보가 있는 모든 학과 포함
 		if admissionTypeKeyword == "경쟁률" {
@@ -290,17 +412,21 @@ func FilterUniversities(c *gin.Context) {
 				continue
 			}
 			location := Location{}
-			if loc, found := universityLocations[record.UniversityName]; found {
-				location = loc
+			if record.Location != nil {
+				location = *record.Location
 			}
 			finalResults = append(finalResults, FilteredUniversity{
-				UniversityID:           fmt.Sprintf("%s-%s", record.UniversityName, record.DepartmentName),
+				UniversityID:           record.UniversityID,
 				UniversityName:         record.UniversityName,
+				CampusID:               record.CampusID,
+				DepartmentID:           record.DepartmentID,
+				AdmissionProgramID:     record.AdmissionProgramID,
 				DepartmentName:         record.DepartmentName,
 				Location:               location,
+				AdmissionTypeResults:   AdmissionTypeResults{},
 				OverallCompetitionRate: record.CompetitionRate,
-				// 세부 전형명 전달
-				AdmissionTypeResults: AdmissionTypeResults{},
+				// 세부 전형명 전달 (프론트에서 활용할 수 있도록)
+				DetailAdmissionType: record.DetailAdmissionType,
 			})
 			continue
 		}
@@ -315,9 +441,15 @@ func FilterUniversities(c *gin.Context) {
 			}
 		}
 
+		// 전형에 내신 계산 스키마가 있으면 그 대학 기준으로 계산한 점수를, 없으면 평균 등급을 씁니다.
+		recordScore := userCalculatedScore
+		if schemeScore := schemeScorer.score(record); schemeScore != nil {
+			recordScore = schemeScore
+		}
+
 		// 경쟁률 필터일 때는 점수차 허용치 필터링을 건너뜀
 		if admissionTypeKeyword != "경쟁률" {
-			if userCalculatedScore != nil {
+			if recordScore != nil {
 				var lastYearScore *float64
 				if record.Cut70 != nil {
 					lastYearScore = record.Cut70
@@ -325,7 +457,7 @@ func FilterUniversities(c *gin.Context) {
 					lastYearScore = record.Cut50
 				}
 				if lastYearScore != nil {
-					if abs(*userCalculatedScore-*lastYearScore) > float64(scoreDifferenceTolerance) {
+					if abs(*recordScore-*lastYearScore) > float64(scoreDifferenceTolerance) {
 						continue
 					}
 				}
@@ -333,13 +465,13 @@ func FilterUniversities(c *gin.Context) {
 		}
 
 		location := Location{}
-		if loc, found := universityLocations[record.UniversityName]; found {
-			location = loc
+		if record.Location != nil {
+			location = *record.Location
 		}
 
 		admissionTypeResults := AdmissionTypeResults{}
 		specificResult := &AdmissionTypeSpecificResults{
-			UserCalculatedScore:         userCalculatedScore,
+			UserCalculatedScore:         recordScore,
 			LastYearAvgConvertedScore:   record.Cut50,
 			LastYear70CutConvertedScore: record.Cut70,
 			SuneungMinSatisfied:         new(bool),
@@ -355,8 +487,11 @@ func FilterUniversities(c *gin.Context) {
 		}
 
 		finalResults = append(finalResults, FilteredUniversity{
-			UniversityID:           fmt.Sprintf("%s-%s", record.UniversityName, record.DepartmentName),
+			UniversityID:           record.UniversityID,
 			UniversityName:         record.UniversityName,
+			CampusID:               record.CampusID,
+			DepartmentID:           record.DepartmentID,
+			AdmissionProgramID:     record.AdmissionProgramID,
 			DepartmentName:         record.DepartmentName,
 			Location:               location,
 			AdmissionTypeResults:   admissionTypeResults,
@@ -366,9 +501,80 @@ func FilterUniversities(c *gin.Context) {
 		})
 	}
 
+	s.metrics.ObserveFilterResults(len(finalResults))
 	c.JSON(http.StatusOK, finalResults)
 }
 
+// gpaSchemeScorer 는 한 요청 안에서 전형별 내신(GPA) 계산 스키마(calculation_schemes)로 학생 점수를 계산합니다.
+// 스키마는 loadSchemes 로 조회 결과의 전형 전체를 한 번에 읽어 두고,
+// 교과 분류는 대학마다 다를 수 있으므로 계산기 입력(GpaScores)은 대학별로 한 번씩 만들어 둡니다.
+type gpaSchemeScorer struct {
+	server  *Server
+	ctx     context.Context
+	catalog *SubjectCatalog
+	naesin  NaesinGrades
+	version string
+	schemes map[string]CalculationScheme // 전형 ID → score_source 가 GPA 인 첫 스키마
+	scores  map[string][]GpaScore        // 대학 ID → 계산기 입력
+}
+
+// usesSchemes 는 record 전형의 점수를 계산 스키마로 계산할 수 있는지 반환합니다. (내신 성적이 있고 수능 전형이 아님)
+func (g *gpaSchemeScorer) usesSchemes(record AdmissionResult) bool {
+	return len(g.naesin) > 0 && g.server.store != nil && !strings.Contains(record.AdmissionType, "수능")
+}
+
+// loadSchemes 는 records 전형들의 GPA 스키마를 한 번의 조회(전형 수가 많으면 몇 번으로 나누어)로 읽어 둡니다.
+func (g *gpaSchemeScorer) loadSchemes(records []AdmissionResult) error {
+	var programIDs []string
+	seen := map[string]bool{}
+	for _, record := range records {
+		if g.usesSchemes(record) && !seen[record.AdmissionProgramID] {
+			seen[record.AdmissionProgramID] = true
+			programIDs = append(programIDs, record.AdmissionProgramID)
+		}
+	}
+	g.schemes = map[string]CalculationScheme{}
+	if len(programIDs) == 0 {
+		return nil
+	}
+	byProgram, err := g.server.store.Schemes.FindSchemesByPrograms(programIDs)
+	if err != nil {
+		return err
+	}
+	for programID, schemes := range byProgram {
+		if i := slices.IndexFunc(schemes, func(scheme CalculationScheme) bool { return scheme.Details.ScoreSource == "GPA" }); i >= 0 {
+			g.schemes[programID] = schemes[i]
+		}
+	}
+	return nil
+}
+
+// score 는 record 전형에 score_source 가 GPA 인 스키마가 있으면 그 스키마로 계산한 점수를 반환합니다.
+// 스키마가 없거나 내신 성적이 없으면 nil 입니다. 스키마의 최종 점수는 입시 결과 컷과 같은 단위(예: 평균 등급)여야 합니다.
+// 계산이 실패하면(5등급제 환산표 누락 등) 경고를 남기고 nil 을 반환하여 평균 등급을 쓰게 합니다.
+func (g *gpaSchemeScorer) score(record AdmissionResult) *float64 {
+	if !g.usesSchemes(record) {
+		return nil
+	}
+	scheme, ok := g.schemes[record.AdmissionProgramID]
+	if !ok {
+		return nil
+	}
+
+	gpaScores, ok := g.scores[record.UniversityID]
+	if !ok {
+		gpaScores = g.catalog.GpaScores(record.UniversityID, g.naesin, g.version)
+		g.scores[record.UniversityID] = gpaScores
+	}
+	score, err := g.server.NewCalculator(g.ctx, gpaScores, nil).Calculate(scheme)
+	if err != nil {
+		LoggerFrom(g.ctx).Warn("계산 스키마로 점수를 계산하지 못해 평균 등급을 씁니다",
+			"admission_program_id", record.AdmissionProgramID, "error", err)
+		return nil
+	}
+	return &score
+}
+
 // abs 함수 추가
 func abs(x float64) float64 {
 	if x < 0 {
@@ -0,0 +1,264 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const filterPath = "/api/universities/filter"
+
+// naesinPayload 는 모든 과목이 grade 등급, credits 단위인 1학년 1학기 내신 성적입니다.
+func naesinPayload(grade int, credits float64, subjects int) map[string]any {
+	list := make([]map[string]any, subjects)
+	for i := range list {
+		list[i] = map[string]any{"id": "s", "subjectName": "국어", "grade": grade, "credits": credits}
+	}
+	return map[string]any{"1-1": list}
+}
+
+func filterRequest(naesin map[string]any, suneung map[string]any, criteria map[string]any) map[string]any {
+	grades := map[string]any{"naesin": naesin}
+	if suneung != nil {
+		grades["suneung"] = suneung
+	}
+	return map[string]any{"userGrades": grades, "filterCriteria": criteria}
+}
+
+func withCurriculumVersion(payload map[string]any, version string) map[string]any {
+	payload["userGrades"].(map[string]any)["curriculumVersion"] = version
+	return payload
+}
+
+func TestFilterUniversities(t *testing.T) {
+	router := newFixtureRouter(t, fixtureRecords())
+
+	tests := []struct {
+		name     string
+		payload  map[string]any
+		wantDept []string // 결과의 학과명 (순서대로)
+	}{
+		{
+			name:     "평균 등급 2.0, 허용 차이 0.5",
+			payload:  filterRequest(naesinPayload(2, 4, 3), nil, map[string]any{"scoreDifferenceTolerance": 0.5}),
+			wantDept: []string{"컴퓨터공학과", "컴퓨터공학과"},
+		},
+		{
+			name:     "학과 코드와 전형 유형",
+			payload:  filterRequest(naesinPayload(5, 4, 2), nil, map[string]any{"departmentKeywords": "C001", "admissionType": "교과", "scoreDifferenceTolerance": 0.5}),
+			wantDept: []string{"소프트웨어학과"},
+		},
+		{
+			name:     "수능 전형은 성적이 없으면 제외",
+			payload:  filterRequest(nil, nil, map[string]any{"admissionType": "수능", "scoreDifferenceTolerance": 9}),
+			wantDept: []string{},
+		},
+		{
+			name:     "경쟁률은 성적과 관계없이 경쟁률이 있는 전형",
+			payload:  filterRequest(nil, nil, map[string]any{"admissionType": "경쟁률"}),
+			wantDept: []string{"컴퓨터공학과", "컴퓨터공학과"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(t, router, http.MethodPost, filterPath, tt.payload)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+			var results []FilteredUniversity
+			decodeJSON(t, w, &results)
+			depts := make([]string, 0, len(results))
+			for _, r := range results {
+				depts = append(depts, r.DepartmentName)
+				assert.NotEmpty(t, r.UniversityID)
+				assert.NotEmpty(t, r.DepartmentID)
+			}
+			assert.Equal(t, tt.wantDept, depts)
+		})
+	}
+}
+
+func TestFilterUniversitiesResultFields(t *testing.T) {
+	router := newFixtureRouter(t, fixtureRecords())
+	payload := filterRequest(naesinPayload(2, 4, 1), nil, map[string]any{"admissionType": "교과", "departmentKeywords": "C001", "scoreDifferenceTolerance": 0.1})
+
+	w := serve(t, router, http.MethodPost, filterPath, payload)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var results []FilteredUniversity
+	decodeJSON(t, w, &results)
+	require.Len(t, results, 1)
+
+	r := results[0]
+	want := fixtureRecords()[0]
+	want.assignIDs()
+	assert.Equal(t, want.UniversityID, r.UniversityID)
+	assert.Equal(t, want.CampusID, r.CampusID)
+	assert.Equal(t, want.AdmissionProgramID, r.AdmissionProgramID)
+	assert.Equal(t, "학교장추천", r.DetailAdmissionType)
+	assert.Equal(t, Location{Latitude: 37.5, Longitude: 127.0}, r.Location)
+	require.NotNil(t, r.AdmissionTypeResults.Gyogwa)
+	assert.Nil(t, r.AdmissionTypeResults.Suneung)
+	require.NotNil(t, r.AdmissionTypeResults.Gyogwa.UserCalculatedScore)
+	assert.InDelta(t, 2.0, *r.AdmissionTypeResults.Gyogwa.UserCalculatedScore, 1e-9)
+	assert.Equal(t, want.Cut70, r.AdmissionTypeResults.Gyogwa.LastYear70CutConvertedScore)
+}
+
+// 경쟁률 필터 결과도 다른 필터처럼 전형 ID와 세부 전형명을 담습니다.
+func TestFilterUniversitiesCompetitionRateFields(t *testing.T) {
+	router := newFixtureRouter(t, fixtureRecords())
+
+	w := serve(t, router, http.MethodPost, filterPath, filterRequest(nil, nil, map[string]any{"admissionType": "경쟁률"}))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var results []FilteredUniversity
+	decodeJSON(t, w, &results)
+	require.Len(t, results, 2)
+
+	records := fixtureRecords()
+	for i, r := range results {
+		want := records[i]
+		want.assignIDs()
+		assert.Equal(t, want.AdmissionProgramID, r.AdmissionProgramID)
+		assert.Equal(t, want.DetailAdmissionType, r.DetailAdmissionType)
+		assert.Equal(t, want.CompetitionRate, r.OverallCompetitionRate)
+	}
+	assert.NotEqual(t, results[0].AdmissionProgramID, results[1].AdmissionProgramID, "같은 학과의 전형은 ID가 달라야 합니다")
+}
+
+// 2022 개정 교육과정(5등급제) 평균 등급은 9등급제로 바꿔 입시 결과와 비교합니다.
+func TestFilterUniversitiesFiveGradeScale(t *testing.T) {
+	router := newFixtureRouter(t, fixtureRecords())
+	payload := withCurriculumVersion(filterRequest(naesinPayload(1, 4, 1), nil, map[string]any{"admissionType": "교과", "scoreDifferenceTolerance": 9}), "2022")
+
+	w := serve(t, router, http.MethodPost, filterPath, payload)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var results []FilteredUniversity
+	decodeJSON(t, w, &results)
+	require.NotEmpty(t, results)
+
+	converted, err := ConvertRank(1, RankScale5, RankScale9)
+	require.NoError(t, err)
+	require.NotNil(t, results[0].AdmissionTypeResults.Gyogwa.UserCalculatedScore)
+	assert.InDelta(t, converted, *results[0].AdmissionTypeResults.Gyogwa.UserCalculatedScore, 1e-9)
+}
+
+func TestFilterUniversitiesErrors(t *testing.T) {
+	router := newFixtureRouter(t, fixtureRecords())
+
+	t.Run("JSON 이 아닌 본문", func(t *testing.T) {
+		w := serve(t, router, http.MethodPost, filterPath, "{not json")
+		requireAPIError(t, w, http.StatusBadRequest, ErrCodeInvalidPayload)
+	})
+
+	tests := []struct {
+		name      string
+		payload   map[string]any
+		wantPaths []string
+	}{
+		{
+			name:      "등급 범위",
+			payload:   filterRequest(naesinPayload(10, 4, 1), nil, map[string]any{}),
+			wantPaths: []string{`userGrades.naesin["1-1"][0].grade`},
+		},
+		{
+			name:      "5등급제 등급 범위",
+			payload:   withCurriculumVersion(filterRequest(naesinPayload(6, 4, 1), nil, map[string]any{}), "2022"),
+			wantPaths: []string{`userGrades.naesin["1-1"][0].grade`},
+		},
+		{
+			name:      "학기 키와 허용 차이",
+			payload:   filterRequest(map[string]any{"4-1": []any{}}, nil, map[string]any{"scoreDifferenceTolerance": -1}),
+			wantPaths: []string{`userGrades.naesin["4-1"]`, "filterCriteria.scoreDifferenceTolerance"},
+		},
+		{
+			name: "수능 과목 키와 원점수",
+			payload: filterRequest(nil, map[string]any{
+				"examYear": 2024, "examMonth": 11,
+				"subjects": map[string]any{
+					"unknown": map[string]any{"rawScore": 10},
+					"english": map[string]any{"rawScore": 120},
+				},
+			}, map[string]any{}),
+			wantPaths: []string{"userGrades.suneung.subjects.english.rawScore", "userGrades.suneung.subjects.unknown"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(t, router, http.MethodPost, filterPath, tt.payload)
+			apiErr := requireAPIError(t, w, http.StatusBadRequest, ErrCodeValidationFailed)
+
+			details, ok := apiErr.Details.(map[string]any)
+			require.True(t, ok, "details 는 객체여야 합니다: %v", apiErr.Details)
+			fields, ok := details["fields"].([]any)
+			require.True(t, ok, "details.fields 는 배열이어야 합니다: %v", details)
+			var paths []string
+			for _, f := range fields {
+				paths = append(paths, f.(map[string]any)["path"].(string))
+			}
+			assert.Equal(t, tt.wantPaths, paths)
+		})
+	}
+}
+
+// socialOnlyScheme 은 "사회" 분류 과목의 단위수 가중 평균 등급을 점수로 쓰는 내신 계산 스키마입니다.
+const socialOnlyScheme = `{"admission_type": "교과", "scheme_details": {"score_source": "GPA", "calculation_pipeline": [
+	{"step": 1, "function_name": "FILTER_SUBJECTS_BY_CATEGORY", "parameters": {"categories": ["사회"]}},
+	{"step": 2, "function_name": "APPLY_GRADE_TO_SCORE_MAP", "parameters": {"map": {"1": 1, "2": 2, "3": 3, "4": 4, "5": 5, "6": 6, "7": 7, "8": 8, "9": 9}}},
+	{"step": 3, "function_name": "CALCULATE_WEIGHTED_AVERAGE", "parameters": {}}]}}`
+
+// 전형에 계산 스키마가 있으면 그 대학의 교과 분류로 과목을 골라 점수를 계산합니다.
+func TestFilterUniversitiesUsesCalculationScheme(t *testing.T) {
+	records := fixtureRecords()
+	gyogwa := records[0]
+	gyogwa.assignIDs()
+
+	naesin := map[string]any{"1-1": []map[string]any{
+		{"id": "a", "subjectCode": "NAESIN_통합사회", "subjectName": "통합사회", "curriculumAreaName": "사회(역사/도덕포함)", "grade": 2, "credits": 3},
+		{"id": "b", "subjectCode": "NAESIN_KOREAN_HISTORY_한국사", "subjectName": "한국사", "curriculumAreaName": "한국사", "grade": 5, "credits": 3},
+	}}
+	payload := filterRequest(naesin, nil, map[string]any{"admissionType": "교과", "departmentKeywords": "C001", "scoreDifferenceTolerance": 9})
+
+	tests := []struct {
+		name      string
+		override  bool
+		wantScore map[string]float64 // 대학명 -> 점수
+	}{
+		// 기본 분류의 사회에는 한국사가 없으므로 통합사회(2등급)만 반영합니다. 스키마가 없는 다라대학교는 평균 등급입니다.
+		{"기본 분류", false, map[string]float64{"가나대학교": 2.0, "다라대학교": 3.5}},
+		// 가나대학교는 사회에 한국사를 넣으므로 한국사(5등급)도 반영합니다.
+		{"대학별 분류", true, map[string]float64{"가나대학교": 3.5, "다라대학교": 3.5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryStore(t)
+			_, err := store.DB.Exec(`INSERT INTO calculation_schemes (id, admission_program_id, admission_type, scheme_json) VALUES (?, ?, ?, ?)`,
+				"gana-gyogwa", gyogwa.AdmissionProgramID, "교과", socialOnlyScheme)
+			require.NoError(t, err)
+			if tt.override {
+				_, err = store.DB.Exec(`INSERT INTO subject_categories (university_id, label, display_order, rule_json) VALUES (?, '사회', 4, ?)`,
+					gyogwa.UniversityID, `{"curriculums": ["사회(역사/도덕포함)", "한국사"]}`)
+				require.NoError(t, err)
+			}
+			server := NewServer(ServerOptions{Store: store})
+			server.LoadRecords(records)
+
+			w := serve(t, server.Router(), http.MethodPost, filterPath, payload)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			var results []FilteredUniversity
+			decodeJSON(t, w, &results)
+
+			scores := map[string]float64{}
+			for _, r := range results {
+				require.NotNil(t, r.AdmissionTypeResults.Gyogwa, r.UniversityName)
+				require.NotNil(t, r.AdmissionTypeResults.Gyogwa.UserCalculatedScore, r.UniversityName)
+				scores[r.UniversityName] = *r.AdmissionTypeResults.Gyogwa.UserCalculatedScore
+			}
+			assert.InDeltaMapValues(t, tt.wantScore, scores, 1e-9)
+			out := scrapeMetrics(t, server.Router())
+			assert.Contains(t, out, `univ_calculator_runs_total{score_source="GPA",result="ok"} 1`)
+			// 스키마는 입시 결과 건수와 관계없이 요청마다 한 번만 조회합니다.
+			assert.Contains(t, out, `univ_db_query_duration_seconds_count{repository="schemes",operation="find_batch",result="ok"} 1`)
+			assert.NotContains(t, out, `repository="schemes",operation="find",`)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- 상태 확인 (liveness / readiness) ---
+// /healthz 는 프로세스가 요청을 처리할 수 있는지만 확인하고(재시작 판단용),
+// /readyz 는 DB 연결과 입시 데이터가 준비되었는지 확인합니다(트래픽 투입 판단용).
+// 등급컷은 아직 적재하는 경로(마이그레이션, import 명령)가 없으므로 건수만 알려 주고 준비 상태에는 반영하지 않습니다.
+
+// readinessTimeout 은 /readyz 의 DB 확인에 쓰는 최대 시간입니다.
+const readinessTimeout = 2 * time.Second
+
+// ReadinessCheck 는 준비 상태 항목 하나의 결과입니다.
+type ReadinessCheck struct {
+	OK     bool   `json:"ok"`
+	Count  *int   `json:"count,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// ReadinessReport 는 /readyz 응답입니다.
+type ReadinessReport struct {
+	Status string                    `json:"status"` // "ready" | "unavailable"
+	Checks map[string]ReadinessCheck `json:"checks"`
+}
+
+// BeginShutdown 은 서버를 종료 중 상태로 표시합니다. 이후 /readyz 는 503 을 반환하여
+// 로드 밸런서가 새 요청을 보내지 않게 합니다.
+func (s *Server) BeginShutdown() {
+	s.shuttingDown.Store(true)
+}
+
+// Healthz 핸들러는 프로세스가 살아 있으면 항상 200 을 반환합니다.
+// GET /healthz
+func (s *Server) Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readyz 핸들러는 서비스 준비 상태를 확인합니다. 하나라도 실패하면 503 입니다.
+// GET /readyz
+func (s *Server) Readyz(c *gin.Context) {
+	report := s.Readiness(c.Request.Context())
+	status := http.StatusOK
+	if report.Status != "ready" {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, report)
+}
+
+// Readiness 는 DB 연결과 입시 데이터 로드를 확인하고, 등급컷 건수를 함께 보고합니다.
+func (s *Server) Readiness(ctx context.Context) ReadinessReport {
+	report := ReadinessReport{Status: "ready", Checks: map[string]ReadinessCheck{}}
+	set := func(name string, check ReadinessCheck) {
+		report.Checks[name] = check
+		if !check.OK {
+			report.Status = "unavailable"
+		}
+	}
+
+	if s.shuttingDown.Load() {
+		set("shutdown", ReadinessCheck{OK: false, Detail: "서버 종료 중"})
+	}
+
+	if s.store == nil {
+		set("database", ReadinessCheck{OK: false, Detail: "저장소가 설정되지 않았습니다"})
+	} else {
+		pingCtx, cancel := context.WithTimeout(ctx, readinessTimeout)
+		defer cancel()
+		if err := s.store.Ping(pingCtx); err != nil {
+			set("database", ReadinessCheck{OK: false, Detail: err.Error()})
+		} else {
+			set("database", ReadinessCheck{OK: true})
+		}
+		set("gradeCuts", countInfo(s.store.GradeCuts.CountGradeCuts, "등급컷이 없습니다 (준비 상태에는 반영하지 않음)"))
+	}
+
+	set("admissionData", countCheck(s.admissions.CountAdmissions, "입시 데이터가 로드되지 않았습니다"))
+	return report
+}
+
+// countCheck 는 count 가 1 이상이면 통과하는 항목을 만듭니다.
+func countCheck(count func() (int, error), emptyDetail string) ReadinessCheck {
+	n, err := count()
+	if err != nil {
+		return ReadinessCheck{OK: false, Detail: err.Error()}
+	}
+	if n == 0 {
+		return ReadinessCheck{OK: false, Count: &n, Detail: emptyDetail}
+	}
+	return ReadinessCheck{OK: true, Count: &n}
+}
+
+// countInfo 는 count 를 알려 주기만 하는 항목을 만듭니다. 비어 있거나 조회에 실패해도 통과로 둡니다.
+// (아직 적재 경로가 없는 데이터를 준비 조건으로 삼으면 /readyz 가 항상 503 이 되기 때문입니다)
+func countInfo(count func() (int, error), emptyDetail string) ReadinessCheck {
+	n, err := count()
+	if err != nil {
+		return ReadinessCheck{OK: true, Detail: err.Error()}
+	}
+	if n == 0 {
+		return ReadinessCheck{OK: true, Count: &n, Detail: emptyDetail}
+	}
+	return ReadinessCheck{OK: true, Count: &n}
+}
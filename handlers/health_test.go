@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadyz(t *testing.T) {
+	t.Run("등급컷이 없어도 준비됨", func(t *testing.T) {
+		router := newFixtureRouter(t, fixtureRecords())
+		w := serve(t, router, http.MethodGet, "/readyz", nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var report ReadinessReport
+		decodeJSON(t, w, &report)
+		assert.Equal(t, "ready", report.Status)
+		assert.Equal(t, ReadinessCheck{OK: true, Count: ptr(4)}, report.Checks["admissionData"])
+		gradeCuts := report.Checks["gradeCuts"]
+		assert.True(t, gradeCuts.OK)
+		assert.Equal(t, ptr(0), gradeCuts.Count)
+		assert.NotEmpty(t, gradeCuts.Detail)
+	})
+	t.Run("입시 데이터가 없으면 503", func(t *testing.T) {
+		router := newFixtureRouter(t, nil)
+		w := serve(t, router, http.MethodGet, "/readyz", nil)
+		require.Equal(t, http.StatusServiceUnavailable, w.Code, w.Body.String())
+
+		var report ReadinessReport
+		decodeJSON(t, w, &report)
+		assert.Equal(t, "unavailable", report.Status)
+		assert.False(t, report.Checks["admissionData"].OK)
+	})
+	t.Run("종료 중이면 503", func(t *testing.T) {
+		server, err := NewFixtureServer(fixtureRecords())
+		require.NoError(t, err)
+		t.Cleanup(func() { server.Store().Close() })
+		server.BeginShutdown()
+
+		w := serve(t, server.Router(), http.MethodGet, "/readyz", nil)
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.False(t, server.Readiness(t.Context()).Checks["shutdown"].OK)
+	})
+}
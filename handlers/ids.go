@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// --- 안정적인 식별자(ID) 생성 ---
+// 대학/캠퍼스/학과/전형 ID는 한글 이름을 그대로 쓰지 않고, 이름 조합의 해시로 만듭니다.
+// 같은 입력에 대해 항상 같은 값이 나오므로 서버를 재시작하거나 데이터를 다시 불러와도 ID가 유지되며,
+// 영문 소문자·숫자·하이픈만 사용하므로 URL 경로에 그대로 넣을 수 있습니다.
+
+const (
+	universityIDPrefix       = "univ"
+	campusIDPrefix           = "camp"
+	departmentIDPrefix       = "dept"
+	admissionProgramIDPrefix = "prog"
+
+	// 해시 앞부분만 사용합니다. (16진수 12자리 = 48비트, 수천 건 규모에서 충돌 가능성은 무시할 수준)
+	stableIDHashLength = 12
+)
+
+// UniversityID 는 대학명으로부터 대학 ID를 만듭니다. (예: "univ-1a2b3c4d5e6f")
+func UniversityID(universityName string) string {
+	return stableID(universityIDPrefix, universityName)
+}
+
+// CampusID 는 대학명과 캠퍼스명으로부터 캠퍼스 ID를 만듭니다.
+func CampusID(universityName, campus string) string {
+	return stableID(campusIDPrefix, universityName, campus)
+}
+
+// DepartmentID 는 대학명, 캠퍼스명, 학과명으로부터 학과(모집단위) ID를 만듭니다.
+func DepartmentID(universityName, campus, departmentName string) string {
+	return stableID(departmentIDPrefix, universityName, campus, departmentName)
+}
+
+// AdmissionProgramID 는 학과 ID의 구성 요소에 전형 유형과 세부 전형명을 더해 전형 ID를 만듭니다.
+func AdmissionProgramID(universityName, campus, departmentName, admissionType, detailAdmissionType string) string {
+	return stableID(admissionProgramIDPrefix, universityName, campus, departmentName, admissionType, detailAdmissionType)
+}
+
+// stableID 는 각 구성 요소를 정규화한 뒤 해시하여 "<prefix>-<hex>" 형태의 ID를 반환합니다.
+func stableID(prefix string, parts ...string) string {
+	normalized := make([]string, len(parts))
+	for i, part := range parts {
+		normalized[i] = normalizeIDPart(part)
+	}
+	// 구성 요소 사이에 이름에 나올 수 없는 구분자(\x1f)를 넣어 "AB"+"C"와 "A"+"BC"가 같은 값이 되지 않게 합니다.
+	sum := sha256.Sum256([]byte(strings.Join(normalized, "\x1f")))
+	return prefix + "-" + hex.EncodeToString(sum[:])[:stableIDHashLength]
+}
+
+// normalizeIDPart 는 앞뒤 공백을 제거하고 연속된 공백을 하나로 합칩니다.
+// CSV와 DB 사이의 사소한 공백 차이로 ID가 달라지지 않도록 하기 위함입니다.
+func normalizeIDPart(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
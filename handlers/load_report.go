@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- 입시 데이터 로드 리포트 ---
+// LoadAdmissionData 가 어떤 행을 읽고 어떤 이유로 건너뛰었는지 기록합니다.
+// 서버가 입시 데이터 0건으로 뜨는 상황을 시작 시점에 발견하기 위한 용도입니다.
+
+// 건너뛴 행의 사유
+const (
+	SkipReasonReadError            = "read_error"            // CSV 파싱 실패
+	SkipReasonShortRow             = "short_row"             // 필요한 열보다 짧은 행
+	SkipReasonNotDaytime           = "not_daytime"           // 주간이 아닌 학과 (학과 정보 CSV)
+	SkipReasonEmptyName            = "empty_name"            // 대학명 또는 학과명 누락
+	SkipReasonUnmatchedDepartment  = "unmatched_department"  // 학과 정보 CSV에 없는 "대학|학과" 조합
+	SkipReasonUnsupportedAdmission = "unsupported_admission" // 수능/교과/종합 이외의 전형
+)
+
+// 리포트에 남길 예시 값의 최대 개수 (전체 개수는 따로 집계합니다)
+const maxReportSamples = 50
+
+// FileLoadReport 는 CSV 파일 하나에 대한 집계입니다.
+type FileLoadReport struct {
+	Path      string         `json:"path"`
+	Error     string         `json:"error,omitempty"` // 파일 열기/헤더 읽기 실패 시
+	RowsRead  int            `json:"rowsRead"`
+	RowsUsed  int            `json:"rowsUsed"`
+	Skipped   map[string]int `json:"skipped"`
+	SkipLines map[string]int `json:"-"` // 사유별 첫 발생 행 번호 (로그용)
+}
+
+// UnparseableValue 는 숫자로 변환하지 못한 컷/경쟁률 값 한 건입니다.
+type UnparseableValue struct {
+	Line  int    `json:"line"`
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// AdmissionLoadReport 는 LoadAdmissionData 한 번의 실행 결과입니다.
+type AdmissionLoadReport struct {
+	StartedAt  time.Time      `json:"startedAt"`
+	Duration   string         `json:"duration"`
+	Locations  int            `json:"universityLocations"`
+	Department FileLoadReport `json:"departmentFile"`
+	Admission  FileLoadReport `json:"admissionFile"`
+	Loaded     int            `json:"admissionRowsLoaded"`
+
+	UnmatchedDepartmentCount int                `json:"unmatchedDepartmentCount"`
+	UnmatchedDepartments     []string           `json:"unmatchedDepartments"` // "대학명|학과명", 최대 maxReportSamples개
+	UnparseableCount         int                `json:"unparseableValueCount"`
+	UnparseableValues        []UnparseableValue `json:"unparseableValues"` // 최대 maxReportSamples개
+
+	unmatchedSeen map[string]bool
+}
+
+// LoadThresholds 는 로드 결과가 이 기준을 넘으면 서버 시작을 중단하기 위한 값입니다.
+// 0 값은 해당 검사를 하지 않음을 뜻합니다. 서버 설정의 기본값은 config.Default 의 data.thresholds 입니다.
+type LoadThresholds struct {
+	MinLoadedRows       int     // 적재된 입시 결과 행의 최소 개수
+	MaxUnmatchedRatio   float64 // 입시 결과 행 중 학과 매칭 실패 비율의 상한 (0~1)
+	MaxUnparseableRatio float64 // 입시 결과 행 수 대비 숫자 변환 실패 건수 비율의 상한 (0~1)
+}
+
+func newAdmissionLoadReport(departmentInfoPath, admissionResultPath string) *AdmissionLoadReport {
+	return &AdmissionLoadReport{
+		StartedAt:            time.Now(),
+		Department:           FileLoadReport{Path: departmentInfoPath, Skipped: map[string]int{}, SkipLines: map[string]int{}},
+		Admission:            FileLoadReport{Path: admissionResultPath, Skipped: map[string]int{}, SkipLines: map[string]int{}},
+		UnmatchedDepartments: []string{},
+		UnparseableValues:    []UnparseableValue{},
+		unmatchedSeen:        map[string]bool{},
+	}
+}
+
+func (f *FileLoadReport) skip(reason string, line int) {
+	f.Skipped[reason]++
+	if _, ok := f.SkipLines[reason]; !ok {
+		f.SkipLines[reason] = line
+	}
+}
+
+func (r *AdmissionLoadReport) addUnmatched(key string) {
+	if r.unmatchedSeen[key] {
+		return
+	}
+	r.unmatchedSeen[key] = true
+	r.UnmatchedDepartmentCount++
+	if len(r.UnmatchedDepartments) < maxReportSamples {
+		r.UnmatchedDepartments = append(r.UnmatchedDepartments, key)
+	}
+}
+
+func (r *AdmissionLoadReport) addUnparseable(line int, field, value string) {
+	r.UnparseableCount++
+	if len(r.UnparseableValues) < maxReportSamples {
+		r.UnparseableValues = append(r.UnparseableValues, UnparseableValue{Line: line, Field: field, Value: value})
+	}
+}
+
+func (r *AdmissionLoadReport) finish() {
+	r.Duration = time.Since(r.StartedAt).Round(time.Millisecond).String()
+	sort.Strings(r.UnmatchedDepartments)
+}
+
+func (s *Server) setLastLoadReport(r *AdmissionLoadReport) {
+	s.lastLoadReportMu.Lock()
+	defer s.lastLoadReportMu.Unlock()
+	s.lastLoadReport = r
+}
+
+// LastLoadReport 는 가장 최근의 로드 리포트를 반환합니다. (로드 전이면 nil)
+// 다시 로드가 기준 미달로 반영되지 않은 경우에도 그 리포트가 최근 리포트가 됩니다.
+func (s *Server) LastLoadReport() *AdmissionLoadReport {
+	s.lastLoadReportMu.RLock()
+	defer s.lastLoadReportMu.RUnlock()
+	return s.lastLoadReport
+}
+
+// Log 는 리포트 요약을 logger 에 남깁니다.
+func (r *AdmissionLoadReport) Log(logger *slog.Logger) {
+	logger.Info("입시 데이터 로드 완료", "duration", r.Duration, "locations", r.Locations, "loaded", r.Loaded)
+	for _, f := range []*FileLoadReport{&r.Department, &r.Admission} {
+		if f.Error != "" {
+			logger.Error("입시 데이터 파일 로드 실패", "path", f.Path, "error", f.Error)
+			continue
+		}
+		logger.Info("입시 데이터 파일", "path", f.Path, "rows_read", f.RowsRead, "rows_used", f.RowsUsed, "skipped", formatSkipped(f))
+	}
+	if r.UnmatchedDepartmentCount > 0 {
+		logger.Warn("학과 매칭 실패", "count", r.UnmatchedDepartmentCount, "examples", strings.Join(firstN(r.UnmatchedDepartments, 5), ", "))
+	}
+	if r.UnparseableCount > 0 {
+		v := r.UnparseableValues[0]
+		logger.Warn("숫자 변환 실패", "count", r.UnparseableCount, "example_line", v.Line, "example_field", v.Field, "example_value", v.Value)
+	}
+}
+
+// CheckThresholds 는 리포트가 기준을 넘으면 에러를 반환합니다.
+// 파일을 열지 못한 경우는 적재 건수가 0이 되므로 MinLoadedRows 로 걸러집니다.
+func (r *AdmissionLoadReport) CheckThresholds(t LoadThresholds) error {
+	var problems []string
+	if t.MinLoadedRows > 0 && r.Loaded < t.MinLoadedRows {
+		problems = append(problems, fmt.Sprintf("적재된 입시 결과 %d건 < 최소 %d건", r.Loaded, t.MinLoadedRows))
+	}
+	if rows := r.Admission.RowsRead; rows > 0 {
+		if ratio := float64(r.Admission.Skipped[SkipReasonUnmatchedDepartment]) / float64(rows); t.MaxUnmatchedRatio > 0 && ratio > t.MaxUnmatchedRatio {
+			problems = append(problems, fmt.Sprintf("학과 매칭 실패 비율 %.1f%% > 허용 %.1f%%", ratio*100, t.MaxUnmatchedRatio*100))
+		}
+		if ratio := float64(r.UnparseableCount) / float64(rows); t.MaxUnparseableRatio > 0 && ratio > t.MaxUnparseableRatio {
+			problems = append(problems, fmt.Sprintf("숫자 변환 실패 비율 %.1f%% > 허용 %.1f%%", ratio*100, t.MaxUnparseableRatio*100))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("입시 데이터 로드 기준 미달: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// GetLoadReport 핸들러는 가장 최근의 입시 데이터 로드 리포트를 반환합니다. (관리자용)
+func (s *Server) GetLoadReport(c *gin.Context) {
+	report := s.LastLoadReport()
+	if report == nil {
+		abortWithError(c, http.StatusNotFound, ErrCodeDataNotLoaded, "아직 입시 데이터를 로드하지 않았습니다.", nil)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+func formatSkipped(f *FileLoadReport) string {
+	if len(f.Skipped) == 0 {
+		return "없음"
+	}
+	reasons := make([]string, 0, len(f.Skipped))
+	for reason := range f.Skipped {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	parts := make([]string, len(reasons))
+	for i, reason := range reasons {
+		parts[i] = fmt.Sprintf("%s=%d(첫 행 %d)", reason, f.Skipped[reason], f.SkipLines[reason])
+	}
+	return strings.Join(parts, ", ")
+}
+
+func firstN(s []string, n int) []string {
+	if len(s) < n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"os"
+	"testing"
+	"univ/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// defaultLoadThresholds 는 서버 기본 설정의 로드 기준값입니다.
+func defaultLoadThresholds() LoadThresholds {
+	t := config.Default().Data.Thresholds
+	return LoadThresholds{MinLoadedRows: t.MinAdmissionRows, MaxUnmatchedRatio: t.MaxUnmatchedRatio, MaxUnparseableRatio: t.MaxUnparseableRatio}
+}
+
+func TestCheckThresholdsWithDefaults(t *testing.T) {
+	deptPath := writeFile(t, "departments.csv", storeTestDepartments)
+
+	tests := []struct {
+		name    string
+		results string
+		wantErr string
+	}{
+		{
+			name:    "정상",
+			results: storeTestResults,
+		},
+		{
+			name:    "빈 파일",
+			results: "대학명,캠퍼스,모집단위,지역,전형유형,전형명,경쟁률,50%컷,70%컷\n",
+			wantErr: "적재된 입시 결과 0건",
+		},
+		{
+			name: "학과 정보와 맞지 않는 입시 결과",
+			results: "대학명,캠퍼스,모집단위,지역,전형유형,전형명,경쟁률,50%컷,70%컷\n" +
+				"가천대학교,본교(제1캠퍼스),컴퓨터공학과,경기,학생부교과,학생부우수자,12.5,2.1,2.5\n" +
+				"가천대학교,본교(제1캠퍼스),물리학과,경기,학생부교과,학생부우수자,9,2.1,2.5\n" +
+				"가천대학교,본교(제1캠퍼스),화학과,경기,학생부교과,학생부우수자,9,2.1,2.5\n",
+			wantErr: "학과 매칭 실패 비율",
+		},
+		{
+			name: "숫자가 아닌 컷",
+			results: "대학명,캠퍼스,모집단위,지역,전형유형,전형명,경쟁률,50%컷,70%컷\n" +
+				"가천대학교,본교(제1캠퍼스),컴퓨터공학과,경기,학생부교과,학생부우수자,n/a,x,y\n",
+			wantErr: "숫자 변환 실패 비율",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := buildAdmissionDataset(DefaultCSVSpecs, nil, deptPath, writeFile(t, "results.csv", tt.results))
+			err := dataset.Report.CheckThresholds(defaultLoadThresholds())
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			// 0 은 검사하지 않음입니다.
+			assert.NoError(t, dataset.Report.CheckThresholds(LoadThresholds{}))
+		})
+	}
+}
+
+// 기준을 넘는 데이터로 다시 로드하면 기존 스냅샷을 그대로 둡니다.
+func TestReloadKeepsDatasetBelowThresholds(t *testing.T) {
+	server := NewServer(ServerOptions{Thresholds: defaultLoadThresholds()})
+	deptPath := writeFile(t, "departments.csv", storeTestDepartments)
+	resultPath := writeFile(t, "results.csv", storeTestResults)
+	report := server.LoadAdmissionData(deptPath, resultPath)
+	require.Equal(t, 3, report.Loaded)
+	before := server.CurrentDataset()
+
+	require.NoError(t, os.WriteFile(resultPath, []byte("대학명,캠퍼스,모집단위,지역,전형유형,전형명,경쟁률,50%컷,70%컷\n"), 0o644))
+	_, err := server.ReloadAdmissionData()
+	require.Error(t, err)
+	assert.Same(t, before, server.CurrentDataset())
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- 구조화 로그 / 요청 ID ---
+// 모든 로그는 log/slog 로 남깁니다. 요청마다 ID를 붙여 request_id 속성을 가진 로거를
+// 요청 context 에 넣어 두므로, 핸들러와 계산기에서 LoggerFrom(ctx) 로 꺼내 쓰면
+// 같은 요청의 로그를 request_id 로 모아 볼 수 있습니다.
+
+// RequestIDHeader 는 요청 ID를 주고받는 헤더입니다. 요청에 있으면 그대로 쓰고(프록시가 붙인 ID), 없으면 새로 만듭니다.
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDPattern 은 클라이언트가 보낸 요청 ID로 받아들일 형식입니다. 로그를 오염시키지 않도록 길이와 문자를 제한합니다.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+type loggerContextKey struct{}
+
+// NewLogger 는 level("debug" | "info" | "warn" | "error")과 format("text" | "json")에 맞는 로거를 만듭니다.
+func NewLogger(w io.Writer, level, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: ParseLogLevel(level)}
+	if format == "json" {
+		return slog.New(slog.NewJSONHandler(w, opts))
+	}
+	return slog.New(slog.NewTextHandler(w, opts))
+}
+
+// ParseLogLevel 은 설정의 로그 레벨 문자열을 slog.Level 로 바꿉니다. 알 수 없는 값은 info 입니다.
+func ParseLogLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// WithLogger 는 logger 를 담은 context 를 반환합니다.
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, logger)
+}
+
+// LoggerFrom 은 ctx 에 담긴 요청 로거를 반환합니다. 없으면 slog.Default() 입니다.
+func LoggerFrom(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(loggerContextKey{}).(*slog.Logger); ok {
+			return logger
+		}
+	}
+	return slog.Default()
+}
+
+// RequestID 는 요청 ID를 정하고(X-Request-ID), 응답 헤더에 돌려주며,
+// request_id 속성을 가진 로거를 요청 context 에 넣는 미들웨어입니다.
+func RequestID(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !requestIDPattern.MatchString(id) {
+			id = newRequestID()
+		}
+		c.Header(RequestIDHeader, id)
+		c.Request = c.Request.WithContext(WithLogger(c.Request.Context(), logger.With("request_id", id)))
+		c.Next()
+	}
+}
+
+// AccessLog 는 요청이 끝나면 메소드, 경로, 상태 코드, 처리 시간을 한 줄로 남기는 미들웨어입니다.
+// 5xx 는 error, 4xx 는 warn, 나머지는 info 레벨입니다. RequestID 뒤에 등록해야 request_id 가 붙습니다.
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+		LoggerFrom(c.Request.Context()).LogAttrs(c.Request.Context(), level, "요청 처리",
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("route", c.FullPath()),
+			slog.Int("status", status),
+			slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.Int("bytes", c.Writer.Size()),
+			slog.String("client_ip", c.ClientIP()),
+		)
+	}
+}
+
+// Recovery 는 핸들러의 panic 을 로그에 남기고 공통 형식의 500 을 응답하는 미들웨어입니다.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, recovered any) {
+		LoggerFrom(c.Request.Context()).Error("핸들러 panic",
+			"panic", recovered, "path", c.Request.URL.Path, "stack", string(debug.Stack()))
+		abortWithError(c, http.StatusInternalServerError, ErrCodeInternal, "서버 내부 오류가 발생했습니다.", nil)
+	})
+}
+
+// newRequestID 는 16바이트 난수로 요청 ID를 만듭니다.
+func newRequestID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
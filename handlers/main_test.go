@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	// 요청/적재 로그는 테스트 출력에 섞이지 않게 버립니다. (go test -v 로 실패 원인을 볼 때는 assert 메시지를 봅니다)
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	os.Exit(m.Run())
+}
@@ -0,0 +1,304 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"runtime"
+	"strconv"
+	"time"
+	"univ/metrics"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- 서버 지표 (/metrics) ---
+// 요청 수/처리 시간, 필터 결과 크기, 점수 계산 단계 실행/실패, 데이터 건수, DB 조회 시간을
+// Prometheus 텍스트 형식으로 내보냅니다. 지표는 Server 마다 따로 가지므로 테스트에서도 서로 섞이지 않습니다.
+
+// filterResultBuckets 는 필터 결과 건수 히스토그램의 구간입니다.
+var filterResultBuckets = []float64{0, 1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500}
+
+// Metrics 는 서버가 기록하는 지표 묶음입니다.
+type Metrics struct {
+	Registry *metrics.Registry
+
+	httpRequests        *metrics.CounterVec
+	httpRequestDuration *metrics.HistogramVec
+	filterResults       *metrics.HistogramVec
+	calculatorRuns      *metrics.CounterVec
+	calculatorSteps     *metrics.CounterVec
+	calculatorFailures  *metrics.CounterVec
+	calculatorSkipped   *metrics.CounterVec
+	dbQueryDuration     *metrics.HistogramVec
+}
+
+// NewMetrics 는 새 Registry 에 서버 지표를 등록합니다.
+func NewMetrics() *Metrics {
+	r := metrics.NewRegistry()
+	m := &Metrics{
+		Registry: r,
+		httpRequests: r.NewCounterVec("univ_http_requests_total",
+			"처리한 HTTP 요청 수", "method", "route", "status"),
+		httpRequestDuration: r.NewHistogramVec("univ_http_request_duration_seconds",
+			"HTTP 요청 처리 시간(초)", metrics.DefaultDurationBuckets, "method", "route"),
+		filterResults: r.NewHistogramVec("univ_filter_results",
+			"POST /api/universities/filter 응답의 대학/학과 건수", filterResultBuckets),
+		calculatorRuns: r.NewCounterVec("univ_calculator_runs_total",
+			"점수 계산 실행 수", "score_source", "result"),
+		calculatorSteps: r.NewCounterVec("univ_calculator_step_runs_total",
+			"점수 계산 단계 실행 수", "function"),
+		calculatorFailures: r.NewCounterVec("univ_calculator_step_failures_total",
+			"점수 계산 단계 실패 수", "function"),
+		calculatorSkipped: r.NewCounterVec("univ_calculator_step_skipped_total",
+			"구현되지 않아 건너뛴 점수 계산 단계 수", "function"),
+		dbQueryDuration: r.NewHistogramVec("univ_db_query_duration_seconds",
+			"저장소 조회 시간(초)", metrics.DefaultDurationBuckets, "repository", "operation", "result"),
+	}
+	r.NewGaugeFunc("go_goroutines", "실행 중인 고루틴 수", func() float64 {
+		return float64(runtime.NumGoroutine())
+	})
+	r.NewGaugeFunc("go_memstats_heap_alloc_bytes", "힙에 할당된 메모리(바이트)", func() float64 {
+		var ms runtime.MemStats
+		runtime.ReadMemStats(&ms)
+		return float64(ms.HeapAlloc)
+	})
+	return m
+}
+
+// registerDatasetGauges 는 입시 데이터/등급컷 건수 게이지를 등록합니다. 값은 /metrics 요청 때 읽습니다.
+func (m *Metrics) registerDatasetGauges(s *Server) {
+	m.Registry.NewGaugeFunc("univ_admission_records", "조회 가능한 입시 결과 건수 (CSV 스냅샷 또는 admission_rules)", func() float64 {
+		return countOrNaN(s.admissions.CountAdmissions)
+	})
+	m.Registry.NewGaugeFunc("univ_dataset_version", "현재 입시 데이터 스냅샷 버전 (DB 모드이면 0)", func() float64 {
+		if dataset := s.CurrentDataset(); dataset != nil {
+			return float64(dataset.Version)
+		}
+		return 0
+	})
+	m.Registry.NewGaugeFunc("univ_dataset_loaded_timestamp_seconds", "현재 입시 데이터 스냅샷을 로드한 시각 (Unix 초)", func() float64 {
+		if dataset := s.CurrentDataset(); dataset != nil {
+			return float64(dataset.LoadedAt.Unix())
+		}
+		return 0
+	})
+	m.Registry.NewGaugeFunc("univ_university_locations", "위치 정보가 있는 대학 수 (현재 스냅샷 기준)", func() float64 {
+		if dataset := s.CurrentDataset(); dataset != nil {
+			return float64(len(dataset.Locations))
+		}
+		return 0
+	})
+	if s.store != nil {
+		m.Registry.NewGaugeFunc("univ_grade_cuts", "exam_grade_cuts 의 등급컷 행 수", func() float64 {
+			return countOrNaN(s.store.GradeCuts.CountGradeCuts)
+		})
+	}
+}
+
+// countOrNaN 은 건수를 읽지 못하면 NaN 을 반환합니다.
+func countOrNaN(count func() (int, error)) float64 {
+	n, err := count()
+	if err != nil {
+		return math.NaN()
+	}
+	return float64(n)
+}
+
+// HTTPMiddleware 는 요청 수와 처리 시간을 경로 패턴(route)별로 기록하는 미들웨어입니다.
+// 등록되지 않은 경로는 route="unmatched" 로 묶어 시계열이 늘어나지 않게 합니다.
+func (m *Metrics) HTTPMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		method := c.Request.Method
+		m.httpRequests.With(method, route, strconv.Itoa(c.Writer.Status())).Inc()
+		m.httpRequestDuration.With(method, route).ObserveDuration(start)
+	}
+}
+
+// ObserveFilterResults 는 필터 응답 건수를 기록합니다.
+func (m *Metrics) ObserveFilterResults(n int) {
+	m.filterResults.With().Observe(float64(n))
+}
+
+// observeCalculation 은 점수 계산 한 번의 결과를 기록합니다. m 이 nil 이면 아무것도 하지 않습니다.
+func (m *Metrics) observeCalculation(scoreSource string, err error) {
+	if m == nil {
+		return
+	}
+	if scoreSource != "GPA" && scoreSource != "CSAT" {
+		scoreSource = "unknown"
+	}
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	m.calculatorRuns.With(scoreSource, result).Inc()
+}
+
+// observeStep 은 계산 단계 한 번의 실행과 실패를 기록합니다.
+func (m *Metrics) observeStep(function string, err error) {
+	if m == nil {
+		return
+	}
+	m.calculatorSteps.With(function).Inc()
+	if err != nil {
+		m.calculatorFailures.With(function).Inc()
+	}
+}
+
+// observeSkippedStep 은 구현되지 않아 건너뛴 단계를 기록합니다.
+func (m *Metrics) observeSkippedStep(function string) {
+	if m == nil {
+		return
+	}
+	m.calculatorSkipped.With(function).Inc()
+}
+
+// observeQuery 는 저장소 조회 시간을 기록합니다.
+func (m *Metrics) observeQuery(repository, operation string, start time.Time, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	m.dbQueryDuration.With(repository, operation, result).ObserveDuration(start)
+}
+
+// MetricsHandler 는 서버 지표를 Prometheus 텍스트 형식으로 반환합니다.
+// GET /metrics
+func (s *Server) MetricsHandler(c *gin.Context) {
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", metrics.ContentType)
+	if err := s.metrics.Registry.WriteText(c.Writer); err != nil {
+		LoggerFrom(c.Request.Context()).Error("지표 출력 실패", "error", err)
+	}
+}
+
+// Metrics 는 서버의 지표 묶음을 반환합니다.
+func (s *Server) Metrics() *Metrics {
+	return s.metrics
+}
+
+// --- 저장소 조회 시간 기록 ---
+// Server 는 저장소를 아래 래퍼로 감싸 조회 시간을 기록합니다. (원래 Store 는 바꾸지 않습니다.)
+
+type instrumentedUniversities struct {
+	next    UniversityRepository
+	metrics *Metrics
+}
+
+func (r instrumentedUniversities) ListUniversities() ([]University, error) {
+	start := time.Now()
+	list, err := r.next.ListUniversities()
+	r.metrics.observeQuery("universities", "list", start, err)
+	return list, err
+}
+
+type instrumentedDepartments struct {
+	next    DepartmentRepository
+	metrics *Metrics
+}
+
+func (r instrumentedDepartments) FindDepartment(id string) (*Department, error) {
+	start := time.Now()
+	d, err := r.next.FindDepartment(id)
+	r.metrics.observeQuery("departments", "find", start, err)
+	return d, err
+}
+
+type instrumentedAdmissions struct {
+	next    AdmissionRepository
+	metrics *Metrics
+}
+
+func (r instrumentedAdmissions) FindAdmissions(q AdmissionQuery) ([]AdmissionResult, error) {
+	start := time.Now()
+	records, err := r.next.FindAdmissions(q)
+	r.metrics.observeQuery("admissions", "find", start, err)
+	return records, err
+}
+
+func (r instrumentedAdmissions) CountAdmissions() (int, error) {
+	start := time.Now()
+	n, err := r.next.CountAdmissions()
+	r.metrics.observeQuery("admissions", "count", start, err)
+	return n, err
+}
+
+type instrumentedSchemes struct {
+	next    SchemeRepository
+	metrics *Metrics
+}
+
+func (r instrumentedSchemes) FindSchemes(admissionProgramID string) ([]CalculationScheme, error) {
+	start := time.Now()
+	schemes, err := r.next.FindSchemes(admissionProgramID)
+	r.metrics.observeQuery("schemes", "find", start, err)
+	return schemes, err
+}
+
+func (r instrumentedSchemes) FindSchemesByPrograms(admissionProgramIDs []string) (map[string][]CalculationScheme, error) {
+	start := time.Now()
+	schemes, err := r.next.FindSchemesByPrograms(admissionProgramIDs)
+	r.metrics.observeQuery("schemes", "find_batch", start, err)
+	return schemes, err
+}
+
+type instrumentedGradeCuts struct {
+	next    GradeCutRepository
+	metrics *Metrics
+}
+
+func (r instrumentedGradeCuts) FindGradeCuts(year, month int) (*ExamGradeCuts, error) {
+	start := time.Now()
+	cuts, err := r.next.FindGradeCuts(year, month)
+	r.metrics.observeQuery("grade_cuts", "find", start, err)
+	return cuts, err
+}
+
+func (r instrumentedGradeCuts) CountGradeCuts() (int, error) {
+	start := time.Now()
+	n, err := r.next.CountGradeCuts()
+	r.metrics.observeQuery("grade_cuts", "count", start, err)
+	return n, err
+}
+
+type instrumentedSubjects struct {
+	next    SubjectRepository
+	metrics *Metrics
+}
+
+func (r instrumentedSubjects) LoadSubjectCatalog() (*SubjectCatalog, error) {
+	start := time.Now()
+	catalog, err := r.next.LoadSubjectCatalog()
+	r.metrics.observeQuery("subjects", "load", start, err)
+	return catalog, err
+}
+
+func (r instrumentedSubjects) SubjectCatalogVersion() (string, error) {
+	start := time.Now()
+	version, err := r.next.SubjectCatalogVersion()
+	r.metrics.observeQuery("subjects", "version", start, err)
+	return version, err
+}
+
+// instrumentStore 는 store 의 저장소를 조회 시간을 기록하는 래퍼로 감싼 사본을 반환합니다.
+// DB 연결은 원래 store 와 공유합니다.
+func (m *Metrics) instrumentStore(store *Store) *Store {
+	if store == nil {
+		return nil
+	}
+	wrapped := *store
+	wrapped.Universities = instrumentedUniversities{next: store.Universities, metrics: m}
+	wrapped.Departments = instrumentedDepartments{next: store.Departments, metrics: m}
+	wrapped.Admissions = instrumentedAdmissions{next: store.Admissions, metrics: m}
+	wrapped.Schemes = instrumentedSchemes{next: store.Schemes, metrics: m}
+	wrapped.GradeCuts = instrumentedGradeCuts{next: store.GradeCuts, metrics: m}
+	wrapped.Subjects = instrumentedSubjects{next: store.Subjects, metrics: m}
+	return &wrapped
+}
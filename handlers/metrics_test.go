@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"univ/metrics"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scrapeMetrics 는 GET /metrics 의 본문을 반환합니다.
+func scrapeMetrics(t *testing.T, router http.Handler) string {
+	t.Helper()
+	w := serve(t, router, http.MethodGet, "/metrics", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, metrics.ContentType, w.Header().Get("Content-Type"))
+	return w.Body.String()
+}
+
+func TestMetricsHandler(t *testing.T) {
+	router := newFixtureRouter(t, fixtureRecords())
+
+	payload := filterRequest(naesinPayload(2, 4, 3), nil, map[string]any{"scoreDifferenceTolerance": 0.5})
+	for range 2 {
+		w := serve(t, router, http.MethodPost, filterPath, payload)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	}
+	serve(t, router, http.MethodPost, filterPath, "{not json")
+	serve(t, router, http.MethodGet, "/api/no-such-route/123", nil)
+	serve(t, router, http.MethodGet, "/api/no-such-route/456", nil)
+
+	out := scrapeMetrics(t, router)
+
+	// 요청 수는 경로 패턴과 상태 코드별로 셉니다. 등록되지 않은 경로는 원래 URL 대신 "unmatched" 하나로 묶습니다.
+	assert.Contains(t, out, `univ_http_requests_total{method="POST",route="/api/universities/filter",status="200"} 2`)
+	assert.Contains(t, out, `univ_http_requests_total{method="POST",route="/api/universities/filter",status="400"} 1`)
+	assert.Contains(t, out, `univ_http_requests_total{method="GET",route="unmatched",status="404"} 2`)
+	assert.NotContains(t, out, "no-such-route")
+
+	// 처리 시간 히스토그램은 상태 코드 없이 method, route 별입니다.
+	assert.Contains(t, out, "# TYPE univ_http_request_duration_seconds histogram")
+	assert.Contains(t, out, `univ_http_request_duration_seconds_bucket{method="POST",route="/api/universities/filter",le="+Inf"} 3`)
+	assert.Contains(t, out, `univ_http_request_duration_seconds_count{method="POST",route="/api/universities/filter"} 3`)
+	assert.Contains(t, out, `univ_http_request_duration_seconds_bucket{method="GET",route="unmatched",le="0.001"}`)
+
+	// 필터 결과 건수는 성공한 응답만 기록합니다. (결과 2건씩 두 번)
+	assert.Contains(t, out, `univ_filter_results_bucket{le="1"} 0`)
+	assert.Contains(t, out, `univ_filter_results_bucket{le="5"} 2`)
+	assert.Contains(t, out, "univ_filter_results_sum 4\n")
+	assert.Contains(t, out, "univ_filter_results_count 2\n")
+
+	// 저장소 조회 시간(서버를 만들 때 과목 목록을 한 번 읽습니다)과 데이터 건수 게이지
+	assert.Contains(t, out, `univ_db_query_duration_seconds_count{repository="subjects",operation="load",result="ok"} 1`)
+	assert.Contains(t, out, "univ_admission_records 4\n")
+
+	// /metrics 요청 자신은 응답을 다 쓴 뒤에 기록되므로 다음 scrape 에 나타납니다.
+	assert.NotContains(t, out, `route="/metrics"`)
+	assert.Contains(t, scrapeMetrics(t, router), `univ_http_requests_total{method="GET",route="/metrics",status="200"} 1`)
+}
+
+// 지표는 서버마다 따로 가지므로 다른 서버의 요청이 섞이지 않습니다.
+func TestMetricsPerServer(t *testing.T) {
+	first := newFixtureRouter(t, fixtureRecords())
+	second := newFixtureRouter(t, fixtureRecords())
+	serve(t, first, http.MethodGet, "/api/no-such-route", nil)
+
+	assert.Contains(t, scrapeMetrics(t, first), `route="unmatched"`)
+	assert.NotContains(t, scrapeMetrics(t, second), `route="unmatched"`)
+}
@@ -0,0 +1,614 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/encoding/korean"
+)
+
+// --- 내신 성적 파일 불러오기 (POST /api/naesin/import) ---
+// 학생부에서 내려받은 성적표(XLSX 또는 CSV)나 나이스 학생부에서 복사한 텍스트(naesin_neis.go)를 서버에서 읽어 NaesinGrades 로 바꿉니다.
+// 브라우저에서 SheetJS 로 파싱하고 행마다 과목 목록 API 를 부르던 과정(내신성적입력.md)을 한 번의 요청으로 대신합니다.
+//
+// 파일의 첫 번째 시트(CSV 는 파일 전체)에서 "학년", "과목" 열이 있는 행을 헤더로 봅니다. 열 이름은 띄어쓰기를 무시합니다.
+//   - 공통 열: 학년, 교과구분종류, 교과, 과목
+//   - 학기 열: "학기" 열이 있으면 한 행이 한 학기이고, 없으면 "1학기 단위수", "2학기 석차등급" 처럼 학기를 붙인 열을 씁니다.
+//     단위수(학점), 석차등급, 원점수, 과목평균, 표준편차(또는 "원점수/과목평균(표준편차)"), 수강자수, 성취도(또는 "성취도(수강자수)"),
+//     성취도별 분포비율("A(15.0) B(30.0) C(35.0)" 또는 분포비율A/B/C)
+
+// naesinImportMaxBytes 는 올릴 수 있는 파일의 최대 크기입니다.
+const naesinImportMaxBytes = 5 << 20
+
+// naesinImportHeaderScanRows 는 헤더 행을 찾을 때 살펴보는 앞쪽 행 수입니다. (학생부 파일은 위에 제목 행이 있기도 함)
+const naesinImportHeaderScanRows = 10
+
+// NaesinImportWarning 은 파일을 읽으면서 건너뛰거나 고친 값에 대한 안내입니다.
+type NaesinImportWarning struct {
+	Row      int    `json:"row"`                // 파일의 행 번호 (1부터, 헤더 포함)
+	Semester string `json:"semester,omitempty"` // 예: "1-2"
+	Field    string `json:"field,omitempty"`    // 열 이름 또는 NaesinSubject 필드 이름
+	Message  string `json:"message"`
+}
+
+// NaesinImportResult 는 POST /api/naesin/import 의 응답입니다. Naesin 은 성적 검증(FilterPayload.Validate)을 통과하는 값만 담습니다.
+type NaesinImportResult struct {
+	Format            string                `json:"format"` // "xlsx" | "csv" | "neis"
+	CurriculumVersion string                `json:"curriculumVersion"`
+	Naesin            NaesinGrades          `json:"naesin"`
+	Warnings          []NaesinImportWarning `json:"warnings"`
+	Rows              int                   `json:"rows"`       // 읽은 데이터 행 수
+	Subjects          int                   `json:"subjects"`   // 만든 학기별 과목 수
+	Unresolved        int                   `json:"unresolved"` // 과목 목록에서 코드를 찾지 못한 과목 수
+}
+
+// naesinImportColumns 는 학기별 값의 필드 → 허용하는 열 이름(띄어쓰기 제외)입니다.
+var naesinImportColumns = []struct {
+	field   string
+	headers []string
+}{
+	{"credits", []string{"단위수", "학점", "학점수"}},
+	{"grade", []string{"석차등급", "등급"}},
+	{"scoreSummary", []string{"원점수/과목평균(표준편차)"}},
+	{"rawScore", []string{"원점수"}},
+	{"subjectMean", []string{"과목평균"}},
+	{"stdDev", []string{"표준편차"}},
+	{"achievementWithCount", []string{"성취도(수강자수)"}},
+	{"studentCount", []string{"수강자수"}},
+	{"achievementLevel", []string{"성취도"}},
+	{"distribution", []string{"성취도별분포비율", "분포비율"}},
+	{"distributionA", []string{"분포비율A", "A비율"}},
+	{"distributionB", []string{"분포비율B", "B비율"}},
+	{"distributionC", []string{"분포비율C", "C비율"}},
+}
+
+var (
+	scoreSummaryPattern         = regexp.MustCompile(`^([\d.]+)\s*/\s*([\d.]+)\s*\(\s*([\d.]+)\s*\)$`)
+	achievementWithCountPattern = regexp.MustCompile(`^([A-Ea-e])\s*\(\s*(\d+)\s*\)$`)
+	distributionPattern         = regexp.MustCompile(`([A-Ca-c])\s*\(\s*([\d.]+)\s*\)`)
+)
+
+// ImportNaesinGrades 핸들러는 내신 성적 파일(XLSX, CSV 또는 나이스 학생부 텍스트)을 읽어 NaesinGrades 와 행별 경고를 반환합니다. 아무것도 저장하지 않습니다.
+// 파일은 multipart 의 file 필드 또는 요청 본문 그대로 보냅니다. curriculumVersion(기본 2015)에 맞는 과목 중에서 코드를 찾습니다.
+// POST /api/naesin/import?curriculumVersion=2015
+func (s *Server) ImportNaesinGrades(c *gin.Context) {
+	version := c.DefaultQuery("curriculumVersion", DefaultCurriculumVersion)
+	if !IsCurriculumVersion(version) {
+		abortWithError(c, http.StatusBadRequest, ErrCodeInvalidParameter, "유효하지 않은 curriculumVersion 값입니다: "+version,
+			gin.H{"parameter": "curriculumVersion", "allowed": curriculumVersions})
+		return
+	}
+
+	data, err := readNaesinImportUpload(c)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			abortWithError(c, http.StatusRequestEntityTooLarge, ErrCodeInvalidPayload, "파일이 너무 큽니다.", gin.H{"maxBytes": naesinImportMaxBytes})
+			return
+		}
+		abortWithError(c, http.StatusBadRequest, ErrCodeInvalidPayload, "성적 파일을 읽을 수 없습니다.", gin.H{"reason": err.Error()})
+		return
+	}
+
+	catalog, err := s.SubjectCatalog()
+	if err != nil {
+		abortWithInternalError(c, "과목 목록 조회 중 에러가 발생했습니다.", err)
+		return
+	}
+
+	result, err := ImportNaesinFile(data, catalog, version)
+	if err != nil {
+		var columns *naesinImportColumnsError
+		if errors.As(err, &columns) {
+			abortWithError(c, http.StatusBadRequest, ErrCodeInvalidPayload, "성적 파일에 필요한 열이 없습니다.", gin.H{"missing": columns.missing})
+			return
+		}
+		abortWithError(c, http.StatusBadRequest, ErrCodeInvalidPayload, "성적 파일을 읽을 수 없습니다.", gin.H{"reason": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// naesinImportColumnsError 는 헤더 행에서 필수 열을 찾지 못했다는 에러입니다.
+type naesinImportColumnsError struct {
+	missing []string
+}
+
+func (e *naesinImportColumnsError) Error() string {
+	return "필요한 열이 없습니다: " + strings.Join(e.missing, ", ")
+}
+
+// ImportNaesinFile 은 내신 성적 파일(XLSX, CSV 또는 나이스 학생부 텍스트)을 읽어 version 교육과정의 NaesinGrades 로 바꿉니다.
+// POST /api/naesin/import 와 `univ parse-naesin` 이 같이 씁니다.
+func ImportNaesinFile(data []byte, catalog *SubjectCatalog, version string) (*NaesinImportResult, error) {
+	format, rows, warnings, err := parseNaesinImportFile(data, catalog)
+	if err != nil {
+		return nil, err
+	}
+	result, missing := buildNaesinImport(rows, catalog, version)
+	if missing != nil {
+		return nil, &naesinImportColumnsError{missing: missing}
+	}
+	result.Format = format
+	if len(warnings) > 0 {
+		// 파일을 읽을 때의 경고와 행을 바꿀 때의 경고를 행 번호 순으로 합칩니다.
+		result.Warnings = append(warnings, result.Warnings...)
+		slices.SortStableFunc(result.Warnings, func(a, b NaesinImportWarning) int { return a.Row - b.Row })
+	}
+	return result, nil
+}
+
+// readNaesinImportUpload 는 multipart 의 file 필드, 없으면 요청 본문 전체를 읽습니다.
+func readNaesinImportUpload(c *gin.Context) ([]byte, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, naesinImportMaxBytes+1<<20) // multipart 헤더 여유
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		fh, err := c.FormFile("file")
+		if err != nil {
+			return nil, fmt.Errorf("multipart 의 file 필드가 필요합니다: %w", err)
+		}
+		if fh.Size > naesinImportMaxBytes {
+			return nil, &http.MaxBytesError{Limit: naesinImportMaxBytes}
+		}
+		f, err := fh.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return io.ReadAll(f)
+	}
+	data, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > naesinImportMaxBytes {
+		return nil, &http.MaxBytesError{Limit: naesinImportMaxBytes}
+	}
+	if len(data) == 0 {
+		return nil, errors.New("파일이 비어 있습니다")
+	}
+	return data, nil
+}
+
+// parseNaesinImportFile 은 파일 앞부분으로 형식(XLSX / XLS / PDF / 텍스트)을 구분하여 행 × 열 문자열로 읽습니다.
+// 텍스트는 나이스 학생부의 교과학습발달상황이면 "neis", 아니면 CSV 로 읽습니다. 나이스 텍스트는 읽으면서 남긴 경고도 반환합니다.
+func parseNaesinImportFile(data []byte, catalog *SubjectCatalog) (string, [][]string, []NaesinImportWarning, error) {
+	switch {
+	case bytes.HasPrefix(data, xlsxSignature):
+		rows, err := readXLSXRows(data)
+		return "xlsx", rows, nil, err
+	case bytes.HasPrefix(data, xlsSignature):
+		return "", nil, nil, errLegacyXLS
+	case bytes.HasPrefix(data, pdfSignature):
+		return "", nil, nil, errNEISPDF
+	case bytes.IndexByte(data, 0) >= 0:
+		return "", nil, nil, errors.New("XLSX, CSV 또는 나이스 학생부 텍스트가 아닙니다")
+	}
+
+	// 엑셀에서 CSV 로 저장하면 UTF-8(BOM 포함) 또는 CP949 입니다.
+	data = bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))
+	if !utf8.Valid(data) {
+		decoded, err := korean.EUCKR.NewDecoder().Bytes(data)
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("텍스트 파일의 문자 인코딩을 알 수 없습니다 (UTF-8 또는 CP949): %w", err)
+		}
+		data = decoded
+	}
+	if isNEISText(string(data)) {
+		rows, warnings, err := parseNEISText(string(data), catalog)
+		return "neis", rows, warnings, err
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	var rows [][]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("CSV 파싱 실패: %w", err)
+		}
+		// csv.Reader 는 빈 줄을 건너뛰므로, 경고의 행 번호가 파일과 맞도록 빈 행을 채웁니다.
+		line, _ := r.FieldPos(0)
+		for len(rows) < line-1 {
+			rows = append(rows, nil)
+		}
+		rows = append(rows, record)
+	}
+	return "csv", rows, nil, nil
+}
+
+// normalizeImportHeader 는 열 이름 비교용으로 띄어쓰기를 지우고 영문을 대문자로 바꿉니다.
+func normalizeImportHeader(h string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, h)
+}
+
+// naesinImportLayout 은 헤더 행에서 찾은 열 위치입니다. 값이 -1 이면 열이 없습니다.
+type naesinImportLayout struct {
+	year, semester, classification, curriculum, subject int
+	// semesters 는 학기(0 은 "학기" 열 사용, 1/2 는 "1학기 …" 열) → 필드 → 열 위치입니다.
+	semesters map[int]map[string]int
+}
+
+// findNaesinImportLayout 은 앞쪽 행에서 헤더 행을 찾습니다. 찾지 못하면 없는 필수 열 목록을 반환합니다.
+func findNaesinImportLayout(rows [][]string) (int, *naesinImportLayout, []string) {
+	var missing []string
+	for i := 0; i < len(rows) && i < naesinImportHeaderScanRows; i++ {
+		index := map[string]int{}
+		for col, h := range rows[i] {
+			if key := normalizeImportHeader(h); key != "" {
+				if _, dup := index[key]; !dup {
+					index[key] = col
+				}
+			}
+		}
+		lookup := func(names ...string) int {
+			for _, name := range names {
+				if col, ok := index[normalizeImportHeader(name)]; ok {
+					return col
+				}
+			}
+			return -1
+		}
+
+		layout := &naesinImportLayout{
+			year:           lookup("학년"),
+			semester:       lookup("학기"),
+			classification: lookup("교과구분종류", "교과구분"),
+			curriculum:     lookup("교과"),
+			subject:        lookup("과목", "과목명"),
+			semesters:      map[int]map[string]int{},
+		}
+		prefixes := map[int]string{1: "1학기", 2: "2학기"}
+		if layout.semester >= 0 {
+			prefixes = map[int]string{0: ""}
+		}
+		for sem, prefix := range prefixes {
+			cols := map[string]int{}
+			for _, column := range naesinImportColumns {
+				names := make([]string, len(column.headers))
+				for j, h := range column.headers {
+					names[j] = prefix + h
+				}
+				if col := lookup(names...); col >= 0 {
+					cols[column.field] = col
+				}
+			}
+			if len(cols) > 0 {
+				layout.semesters[sem] = cols
+			}
+		}
+
+		var m []string
+		if layout.year < 0 {
+			m = append(m, "학년")
+		}
+		if layout.subject < 0 {
+			m = append(m, "과목")
+		}
+		if len(layout.semesters) == 0 {
+			m = append(m, "단위수 (\"학기\" 열과 함께, 또는 \"1학기 단위수\" 형식)")
+		} else {
+			for sem, cols := range layout.semesters {
+				if _, ok := cols["credits"]; !ok {
+					m = append(m, strings.TrimSpace(prefixes[sem]+" 단위수"))
+				}
+			}
+		}
+		if len(m) == 0 {
+			return i, layout, nil
+		}
+		if missing == nil || len(m) < len(missing) {
+			missing = m
+		}
+	}
+	if missing == nil {
+		missing = []string{"학년", "과목"}
+	}
+	slices.Sort(missing)
+	return -1, nil, missing
+}
+
+// naesinImportBuilder 는 행을 읽으며 결과와 경고를 모읍니다.
+type naesinImportBuilder struct {
+	result  *NaesinImportResult
+	catalog *SubjectCatalog
+	version string
+	row     int    // 현재 행 번호 (1부터)
+	sem     string // 현재 학기 키
+}
+
+func (b *naesinImportBuilder) warn(field, format string, args ...interface{}) {
+	b.result.Warnings = append(b.result.Warnings, NaesinImportWarning{Row: b.row, Semester: b.sem, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// buildNaesinImport 는 파일의 행을 NaesinGrades 로 바꿉니다. 헤더 행을 찾지 못하면 없는 열 목록을 반환합니다.
+func buildNaesinImport(rows [][]string, catalog *SubjectCatalog, version string) (*NaesinImportResult, []string) {
+	headerRow, layout, missing := findNaesinImportLayout(rows)
+	if layout == nil {
+		return nil, missing
+	}
+	b := &naesinImportBuilder{
+		result:  &NaesinImportResult{CurriculumVersion: version, Naesin: NaesinGrades{}, Warnings: []NaesinImportWarning{}},
+		catalog: catalog,
+		version: version,
+	}
+	accept := func(a CurriculumApplicability) bool {
+		return slices.Contains(compatibleCurriculumVersions[version], a.CurriculumVersion)
+	}
+
+	for i := headerRow + 1; i < len(rows); i++ {
+		row := rows[i]
+		cell := func(col int) string {
+			if col < 0 || col >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[col])
+		}
+		b.row, b.sem = i+1, ""
+		if slices.IndexFunc(row, func(v string) bool { return strings.TrimSpace(v) != "" }) < 0 {
+			continue // 빈 행
+		}
+		b.result.Rows++
+
+		query := SubjectResolveQuery{
+			Classification: cell(layout.classification),
+			Curriculum:     cell(layout.curriculum),
+			Subject:        cell(layout.subject),
+		}
+		year, ok := parseImportOrdinal(cell(layout.year), "학년")
+		if !ok || year < 1 || year > 3 {
+			b.warn("학년", "학년이 올바르지 않아 행을 건너뜁니다: %q", cell(layout.year))
+			continue
+		}
+		if query.Subject == "" {
+			b.warn("과목", "과목명이 비어 있어 행을 건너뜁니다")
+			continue
+		}
+		semesters := []int{1, 2}
+		if layout.semester >= 0 {
+			sem, ok := parseImportOrdinal(cell(layout.semester), "학기")
+			if !ok || sem < 1 || sem > 2 {
+				b.warn("학기", "학기가 올바르지 않아 행을 건너뜁니다: %q", cell(layout.semester))
+				continue
+			}
+			semesters = []int{sem}
+		}
+
+		match := b.resolve(query, accept)
+		for _, sem := range semesters {
+			cols := layout.semesters[0]
+			if layout.semester < 0 {
+				cols = layout.semesters[sem]
+			}
+			if cols == nil {
+				continue
+			}
+			b.sem = fmt.Sprintf("%d-%d", year, sem)
+			subject, ok := b.readSemester(row, cols, query, match)
+			if !ok {
+				continue
+			}
+			subject.ID = fmt.Sprintf("import-%d-%d", b.row, sem)
+			b.result.Naesin[b.sem] = append(b.result.Naesin[b.sem], subject)
+			b.result.Subjects++
+			if subject.SubjectCode == nil {
+				b.result.Unresolved++
+			}
+		}
+	}
+	return b.result, nil
+}
+
+// resolve 는 행의 이름으로 과목 목록의 코드를 찾고, 확실하지 않으면 경고를 남깁니다. 찾지 못하면 nil 입니다.
+func (b *naesinImportBuilder) resolve(query SubjectResolveQuery, accept func(CurriculumApplicability) bool) *SubjectMatch {
+	res := b.catalog.resolver.resolve(query, accept)
+	switch {
+	case res.Match == nil:
+		b.warn("과목", "%s 개정 교육과정 과목 목록에서 찾지 못했습니다: %q (코드 없이 이름만 넣습니다)", b.version, query.Subject)
+	case res.Ambiguous:
+		b.warn("과목", "같은 이름의 과목이 여러 교과에 있어 %s 교과의 과목으로 골랐습니다. 교과를 확인하세요: %q", res.Match.CurriculumName, query.Subject)
+	case res.Match.Confidence < 1:
+		b.warn("과목", "%q 를 %q(%s 교과)로 찾았습니다 (신뢰도 %.2f)", query.Subject, res.Match.SubjectName, res.Match.CurriculumName, res.Match.Confidence)
+	}
+	return res.Match
+}
+
+// readSemester 는 한 행의 한 학기 값을 읽습니다. 단위수가 없으면 그 학기는 수강하지 않은 것으로 보고 false 를 반환합니다.
+func (b *naesinImportBuilder) readSemester(row []string, cols map[string]int, query SubjectResolveQuery, match *SubjectMatch) (NaesinSubject, bool) {
+	cell := func(field string) string {
+		col, ok := cols[field]
+		if !ok || col >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[col])
+	}
+	var s NaesinSubject
+
+	credits := b.number("credits", cell("credits"))
+	if credits == nil {
+		for field := range cols {
+			if !isEmptyImportValue(cell(field)) {
+				b.warn("credits", "단위수가 없어 이 학기 값을 건너뜁니다")
+				break
+			}
+		}
+		return s, false
+	}
+	s.Credits = credits
+
+	if match != nil {
+		s.CurriculumClassificationCode, s.CurriculumClassificationName = &match.ClassificationCode, &match.ClassificationName
+		s.CurriculumAreaCode, s.CurriculumAreaName = &match.CurriculumCode, &match.CurriculumName
+		s.SubjectCode, s.SubjectName = &match.SubjectCode, match.SubjectName
+	} else {
+		s.CurriculumClassificationName = optionalString(query.Classification)
+		s.CurriculumAreaName = optionalString(query.Curriculum)
+		s.SubjectName = query.Subject
+	}
+
+	if v := b.number("grade", cell("grade")); v != nil {
+		if *v != math.Trunc(*v) {
+			b.warn("grade", "석차등급은 정수여야 합니다: %g", *v)
+		} else {
+			g := int(*v)
+			s.Grade = &g
+		}
+	}
+	if summary := cell("scoreSummary"); !isEmptyImportValue(summary) {
+		if m := scoreSummaryPattern.FindStringSubmatch(summary); m != nil {
+			s.RawScore, s.SubjectMean, s.StdDev = b.number("rawScore", m[1]), b.number("subjectMean", m[2]), b.number("stdDev", m[3])
+		} else {
+			b.warn("scoreSummary", "\"원점수/과목평균(표준편차)\" 형식이 아닙니다: %q", summary)
+		}
+	}
+	b.numbers(cell, []importNumberField{{"rawScore", &s.RawScore}, {"subjectMean", &s.SubjectMean}, {"stdDev", &s.StdDev}})
+
+	if ac := cell("achievementWithCount"); !isEmptyImportValue(ac) {
+		if m := achievementWithCountPattern.FindStringSubmatch(ac); m != nil {
+			level := strings.ToUpper(m[1])
+			n, _ := strconv.Atoi(m[2])
+			s.AchievementLevel, s.StudentCount = &level, &n
+		} else {
+			b.warn("achievementWithCount", "\"성취도(수강자수)\" 형식이 아닙니다: %q", ac)
+		}
+	}
+	if level := cell("achievementLevel"); !isEmptyImportValue(level) {
+		level = strings.ToUpper(level)
+		s.AchievementLevel = &level
+	}
+	if v := b.number("studentCount", cell("studentCount")); v != nil {
+		n := int(*v)
+		s.StudentCount = &n
+	}
+
+	if dist := cell("distribution"); !isEmptyImportValue(dist) {
+		matches := distributionPattern.FindAllStringSubmatch(dist, -1)
+		if len(matches) == 0 {
+			b.warn("distribution", "\"A(15.0) B(30.0) C(35.0)\" 형식이 아닙니다: %q", dist)
+		}
+		for _, m := range matches {
+			v := b.number("distribution"+strings.ToUpper(m[1]), m[2])
+			switch strings.ToUpper(m[1]) {
+			case "A":
+				s.DistributionA = v
+			case "B":
+				s.DistributionB = v
+			case "C":
+				s.DistributionC = v
+			}
+		}
+	}
+	b.numbers(cell, []importNumberField{{"distributionA", &s.DistributionA}, {"distributionB", &s.DistributionB}, {"distributionC", &s.DistributionC}})
+
+	b.dropInvalid(&s)
+	return s, true
+}
+
+// dropInvalid 는 성적 검증(validateNaesinSubject)에 걸리는 값을 경고와 함께 비웁니다. 결과를 그대로 필터 요청에 쓸 수 있게 합니다.
+func (b *naesinImportBuilder) dropInvalid(s *NaesinSubject) {
+	var errs fieldErrors
+	validateNaesinSubject(&errs, "", *s, b.catalog.codes, b.version)
+	for _, e := range errs {
+		field := strings.TrimPrefix(e.Path, ".")
+		b.warn(field, "%s (값을 비웁니다)", e.Message)
+		switch field {
+		case "grade":
+			s.Grade = nil
+		case "credits":
+			s.Credits = nil
+		case "rawScore":
+			s.RawScore = nil
+		case "subjectMean":
+			s.SubjectMean = nil
+		case "stdDev":
+			s.StdDev = nil
+		case "studentCount":
+			s.StudentCount = nil
+		case "achievementLevel":
+			s.AchievementLevel = nil
+		case "distributionA":
+			s.DistributionA = nil
+		case "distributionB":
+			s.DistributionB = nil
+		case "distributionC":
+			s.DistributionC = nil
+		case "": // 분포비율 합계
+			s.DistributionA, s.DistributionB, s.DistributionC = nil, nil, nil
+		case "curriculumClassificationCode", "curriculumAreaCode", "subjectCode":
+			s.CurriculumClassificationCode, s.CurriculumAreaCode, s.SubjectCode = nil, nil, nil
+		}
+	}
+}
+
+// number 는 셀 값을 숫자로 읽습니다. 비어 있거나 "-", "P" 같은 값은 nil 이고, 숫자가 아니면 경고를 남기고 nil 입니다.
+func (b *naesinImportBuilder) number(field, v string) *float64 {
+	if isEmptyImportValue(v) {
+		return nil
+	}
+	f, err := strconv.ParseFloat(strings.ReplaceAll(v, ",", ""), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		b.warn(field, "숫자가 아닙니다: %q", v)
+		return nil
+	}
+	return &f
+}
+
+// importNumberField 는 따로 된 열에서 읽을 숫자 필드입니다. 합쳐진 열("원점수/과목평균(표준편차)" 등)보다 우선합니다.
+type importNumberField struct {
+	field  string
+	target **float64
+}
+
+func (b *naesinImportBuilder) numbers(cell func(string) string, fields []importNumberField) {
+	for _, f := range fields {
+		if v := b.number(f.field, cell(f.field)); v != nil {
+			*f.target = v
+		}
+	}
+}
+
+// isEmptyImportValue 는 학생부에서 값이 없음을 뜻하는 셀인지 확인합니다. (P 는 석차등급이 없는 과목)
+func isEmptyImportValue(v string) bool {
+	switch strings.TrimSpace(v) {
+	case "", "-", "－", ".", "P":
+		return true
+	}
+	return false
+}
+
+// parseImportOrdinal 은 "1", "1.0", "1학년" 같은 값을 정수로 읽습니다.
+func parseImportOrdinal(v, suffix string) (int, bool) {
+	v = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(v), suffix))
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f != math.Trunc(f) {
+		return 0, false
+	}
+	return int(f), true
+}
+
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
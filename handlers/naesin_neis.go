@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// --- 나이스 학생부 텍스트 읽기 ---
+// 학생 대부분은 정리된 성적표 대신 나이스 대국민서비스에서 받은 학교생활기록부 PDF 를 가지고 있습니다.
+// PDF 뷰어나 나이스 화면에서 "교과학습발달상황" 부분을 복사한 텍스트를 읽어, POST /api/naesin/import 의 다른 형식과
+// 같은 표(학기별 한 행)로 바꿉니다. 과목 코드 찾기와 값 검증은 buildNaesinImport 가 그대로 합니다.
+//
+// 읽는 텍스트의 모양 (data/naesin-samples 에 익명화한 예시가 있습니다):
+//
+//	[1학년]
+//	학기 교과 과목 단위수 원점수/과목평균 (표준편차) 성취도 (수강자수) 석차등급 비고
+//	1 국어 국어 4 92/71.3(15.2) A(245) 2
+//	<진로 선택 과목>
+//	학기 교과 과목 단위수 원점수/과목평균 성취도 (수강자수) 성취도별 분포비율 비고
+//	2 과학 물리학 실험 2 95/78.2 A(120) A(35.2) B(40.1) C(24.7)
+//
+//   - "[1학년]" 또는 "1학년" 줄이 이후 행의 학년을 정합니다.
+//   - "학기 … 과목 … 단위수(학점)" 헤더 줄 다음부터, "세부능력 및 특기사항" 이나 다음 학년 줄 전까지 "1"/"2" 로 시작하는 줄을 과목 행으로 읽습니다.
+//   - 한 행은 학기, 교과, 과목, 단위수 뒤에 값이 모양으로 구분되어 옵니다. 원점수/과목평균(표준편차), 성취도(수강자수), 석차등급,
+//     성취도별 분포비율 순이고, 빠진 값이 있어도 됩니다. 그 뒤의 글자는 비고로 보고 버립니다.
+//   - 나이스 화면에서 복사하면 칸이 탭으로 나뉘므로, 탭이 있는 줄은 탭으로 칸을 나눕니다.
+//
+// PDF 파일 자체는 글꼴마다 한글 인코딩이 달라 서버에서 읽지 않습니다. 텍스트를 복사해서 보내야 합니다.
+
+var (
+	// pdfSignature 는 PDF 파일의 시작입니다.
+	pdfSignature = []byte("%PDF-")
+	// errNEISPDF 는 학생부 PDF 파일을 그대로 올렸을 때의 에러입니다.
+	errNEISPDF = errors.New("PDF 파일은 읽을 수 없습니다. PDF 에서 교과학습발달상황 부분을 복사하여 텍스트로 보내 주세요")
+)
+
+// neisTableHeader 는 parseNEISText 가 만드는 표의 헤더입니다. (findNaesinImportLayout 이 읽는 열 이름)
+var neisTableHeader = []string{"학년", "학기", "교과구분종류", "교과", "과목", "단위수", "원점수", "과목평균", "표준편차",
+	"성취도", "수강자수", "석차등급", "분포비율A", "분포비율B", "분포비율C"}
+
+var (
+	neisYearPattern        = regexp.MustCompile(`^\[?\s*([1-3])\s*학년\s*\]?$`)
+	neisSubsectionPattern  = regexp.MustCompile(`^<(.+)>$`)
+	neisNumberPattern      = regexp.MustCompile(`^\d+(?:\.\d+)?$`)
+	neisScorePattern       = regexp.MustCompile(`^(\d+(?:\.\d+)?)/(\d+(?:\.\d+)?)(?:\((\d+(?:\.\d+)?)\))?$`)
+	neisAchievementPattern = regexp.MustCompile(`^([A-EP])(?:\((\d+)\))?$`)
+	neisDistributionToken  = regexp.MustCompile(`^([A-C])\((\d+(?:\.\d+)?)\)$`)
+	neisGradePattern       = regexp.MustCompile(`^\d{1,2}$`)
+	// PDF 에서 복사하면 "92/71.3 (15.2)", "A (245)" 처럼 괄호와 빗금 앞뒤에 공백이 끼므로 붙여서 한 칸으로 만듭니다.
+	neisOpenParen  = regexp.MustCompile(` *\( *`)
+	neisCloseParen = regexp.MustCompile(` *\)`)
+	neisSlash      = regexp.MustCompile(` */ *`)
+)
+
+// isNEISText 는 텍스트가 CSV 가 아니라 나이스 학생부의 교과학습발달상황인지 확인합니다.
+// 쉼표 없는 나이스 표 헤더 줄이 있으면 학생부로 봅니다. (CSV 성적표도 제목 행에 "교과학습발달상황" 이 있을 수 있어 섹션 제목은 보지 않습니다)
+func isNEISText(text string) bool {
+	for _, line := range strings.Split(text, "\n") {
+		if !strings.Contains(line, ",") && isNEISTableHeader(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNEISTableHeader 는 "학기 교과 과목 단위수 …" 처럼 교과학습발달상황 표의 헤더 줄인지 확인합니다. (2022 개정은 "학점")
+func isNEISTableHeader(line string) bool {
+	h := normalizeImportHeader(line)
+	return strings.HasPrefix(h, "학기") && strings.Contains(h, "과목") && (strings.Contains(h, "단위수") || strings.Contains(h, "학점"))
+}
+
+// neisParser 는 학생부 텍스트를 한 줄씩 읽는 상태입니다.
+type neisParser struct {
+	curriculumNames map[string]bool // 정규화한 교과 이름 (교과와 과목 이름을 나눌 때 씀)
+	rows            [][]string
+	warnings        []NaesinImportWarning
+	line            int    // 현재 줄 번호 (1부터)
+	year            string // 현재 학년, 모르면 ""
+	classification  string // 현재 소단원의 교과구분종류 이름 (예: "<진로 선택 과목>" 아래는 "진로선택")
+	inTable         bool   // 헤더 줄 다음의 과목 행을 읽는 중
+}
+
+// parseNEISText 는 학생부 텍스트를 buildNaesinImport 가 읽는 표로 바꿉니다.
+// 과목 행은 줄 번호와 같은 행 번호에 두므로(0행은 헤더), 경고의 행 번호가 텍스트의 줄 번호와 같습니다.
+func parseNEISText(text string, catalog *SubjectCatalog) ([][]string, []NaesinImportWarning, error) {
+	p := &neisParser{curriculumNames: map[string]bool{}, rows: [][]string{neisTableHeader}}
+	for _, curr := range catalog.Curriculums {
+		p.curriculumNames[normalizeCatalogName(curr.Name)] = true
+	}
+	subjects := 0
+	for i, line := range strings.Split(text, "\n") {
+		p.line = i + 1
+		if row := p.readLine(strings.TrimRight(line, "\r")); row != nil {
+			for len(p.rows) < p.line-1 {
+				p.rows = append(p.rows, nil)
+			}
+			p.rows = append(p.rows, row)
+			subjects++
+		}
+	}
+	if subjects == 0 {
+		return nil, nil, errors.New("교과학습발달상황 표에서 과목 행을 찾지 못했습니다. \"학기 교과 과목 단위수 …\" 헤더 줄까지 복사했는지 확인하세요")
+	}
+	return p.rows, p.warnings, nil
+}
+
+func (p *neisParser) warn(format string, args ...interface{}) {
+	p.warnings = append(p.warnings, NaesinImportWarning{Row: p.line, Message: fmt.Sprintf(format, args...)})
+}
+
+// readLine 은 한 줄을 읽어 상태를 바꾸고, 과목 행이면 표의 한 행을 반환합니다.
+func (p *neisParser) readLine(line string) []string {
+	trimmed := strings.TrimSpace(line)
+	compact := normalizeImportHeader(trimmed)
+	switch {
+	case trimmed == "":
+		return nil
+	case neisYearPattern.MatchString(trimmed):
+		p.year = neisYearPattern.FindStringSubmatch(trimmed)[1]
+		p.classification, p.inTable = "", false
+		return nil
+	case neisSubsectionPattern.MatchString(trimmed):
+		p.classification, p.inTable = "", false
+		if strings.Contains(compact, "진로선택") {
+			p.classification = "진로선택"
+		}
+		return nil
+	case isNEISTableHeader(trimmed):
+		p.inTable = true
+		return nil
+	case strings.HasPrefix(compact, "세부능력") || strings.HasPrefix(compact, "이수단위합계") || strings.HasPrefix(compact, "이수학점합계"):
+		// 세부능력 및 특기사항은 글이 길어 과목 행처럼 보이는 줄이 섞이므로 다음 헤더 줄까지 읽지 않습니다.
+		p.inTable = false
+		return nil
+	case !p.inTable:
+		return nil
+	}
+
+	fields := splitNEISLine(line)
+	if len(fields) < 4 || (fields[0] != "1" && fields[0] != "2") {
+		return nil // 쪽 번호, 머리말 등
+	}
+	return p.readRow(fields)
+}
+
+// splitNEISLine 은 과목 행을 칸으로 나눕니다. 탭이 있으면 탭으로, 없으면 공백으로 나눕니다.
+func splitNEISLine(line string) []string {
+	line = neisOpenParen.ReplaceAllString(line, "(")
+	line = neisCloseParen.ReplaceAllString(line, ")")
+	line = neisSlash.ReplaceAllString(line, "/")
+	if !strings.Contains(line, "\t") {
+		return strings.Fields(line)
+	}
+	var fields []string
+	for _, f := range strings.Split(line, "\t") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// readRow 는 "학기 교과 과목 단위수 값…" 칸을 neisTableHeader 순서의 한 행으로 바꿉니다.
+func (p *neisParser) readRow(fields []string) []string {
+	if p.year == "" {
+		p.warn("학년을 알 수 없어 행을 건너뜁니다. \"[1학년]\" 줄까지 복사했는지 확인하세요")
+		return nil
+	}
+	// 과목 이름에 공백이 있을 수 있으므로("확률과 통계") 교과와 과목 뒤의 첫 숫자 칸을 단위수로 봅니다.
+	credits := -1
+	for i := 3; i < len(fields); i++ {
+		if neisNumberPattern.MatchString(fields[i]) {
+			credits = i
+			break
+		}
+	}
+	if credits < 0 {
+		p.warn("단위수를 찾지 못해 행을 건너뜁니다: %q", strings.Join(fields, " "))
+		return nil
+	}
+	curriculum, subject := p.splitNames(fields[1:credits])
+
+	row := make([]string, len(neisTableHeader))
+	row[0], row[1], row[2], row[3], row[4], row[5] = p.year, fields[0], p.classification, curriculum, subject, fields[credits]
+	achievement := false
+	// 탭으로 나눈 줄은 "A(38.7) B(41.9) C(19.4)" 처럼 한 칸에 값이 여러 개일 수 있으므로 값 칸은 다시 공백으로 나눕니다.
+	for _, f := range strings.Fields(strings.Join(fields[credits+1:], " ")) {
+		if m := neisScorePattern.FindStringSubmatch(f); m != nil && row[6] == "" {
+			row[6], row[7], row[8] = m[1], m[2], m[3]
+			continue
+		}
+		// 성취도(수강자수)가 먼저 오고, 그 뒤의 "A(35.2)" 는 분포비율입니다.
+		if m := neisAchievementPattern.FindStringSubmatch(f); m != nil && !achievement {
+			achievement = true
+			if m[1] != "P" {
+				row[9] = m[1]
+			}
+			row[10] = m[2]
+			continue
+		}
+		if m := neisDistributionToken.FindStringSubmatch(f); m != nil && achievement {
+			row[12+int(m[1][0]-'A')] = m[2]
+			continue
+		}
+		if neisGradePattern.MatchString(f) && row[11] == "" {
+			row[11] = f
+			continue
+		}
+		if f == "P" || f == "-" {
+			continue
+		}
+		break // 비고
+	}
+	return row
+}
+
+// splitNames 는 교과와 과목 이름 칸을 나눕니다. 교과 이름에도 공백이 있을 수 있으므로("과학 계열")
+// 과목 목록의 교과 이름과 맞는 가장 긴 앞부분을 교과로 보고, 맞는 것이 없으면 첫 칸을 교과로 봅니다.
+func (p *neisParser) splitNames(names []string) (string, string) {
+	for k := len(names) - 1; k > 1; k-- {
+		if p.curriculumNames[normalizeCatalogName(strings.Join(names[:k], ""))] {
+			return strings.Join(names[:k], " "), strings.Join(names[k:], " ")
+		}
+	}
+	return names[0], strings.Join(names[1:], " ")
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// naesinSamplesDir 는 익명화한 나이스 학생부 예시와 기대 결과(.json)가 있는 디렉터리입니다. (`univ parse-naesin -check` 와 같은 파일)
+const naesinSamplesDir = "../data/naesin-samples"
+
+func testSubjectCatalog(t *testing.T) *SubjectCatalog {
+	t.Helper()
+	catalog, err := newMemoryStore(t).Subjects.LoadSubjectCatalog()
+	require.NoError(t, err)
+	return catalog
+}
+
+// findImported 는 semester 학기에서 subjectName 과목을 찾습니다.
+func findImported(t *testing.T, grades NaesinGrades, semester, subjectName string) NaesinSubject {
+	t.Helper()
+	for _, s := range grades[semester] {
+		if s.SubjectName == subjectName {
+			return s
+		}
+	}
+	require.Failf(t, "과목이 없습니다", "%s %s", semester, subjectName)
+	return NaesinSubject{}
+}
+
+func TestNEISSamples(t *testing.T) {
+	catalog := testSubjectCatalog(t)
+
+	type subjectWant struct {
+		semester string
+		name     string
+		credits  *float64
+		grade    *int
+		level    string
+	}
+	tests := []struct {
+		sample   string
+		version  string
+		counts   map[string]int // 학기별 과목 수
+		subjects []subjectWant
+		warnRows []int
+	}{
+		{
+			sample:  "neis-2015-pdf",
+			version: "2015",
+			counts:  map[string]int{"1-1": 9, "1-2": 9, "2-1": 7, "2-2": 8},
+			subjects: []subjectWant{
+				{"1-1", "국어", ptr(4.0), ptr(2), "A"},
+				{"1-2", "수학", ptr(4.0), ptr(4), "C"},
+				{"2-2", "확률과 통계", ptr(3.0), ptr(2), "A"}, // 과목 이름에 공백
+				{"1-1", "과학탐구실험", ptr(1.0), nil, ""},     // 성취도 P 는 값 없음
+				{"2-1", "물리학 실험", ptr(2.0), nil, "A"},    // 진로 선택 과목은 등급 없음
+				{"1-1", "통합사회", ptr(3.0), ptr(2), "A"},   // 교과 이름에 괄호
+			},
+			warnRows: []int{50},
+		},
+		{
+			sample:  "neis-2015-web-tabs",
+			version: "2015",
+			counts:  map[string]int{"3-1": 5, "3-2": 4},
+			subjects: []subjectWant{
+				{"3-1", "화법과 작문", ptr(4.0), ptr(2), "A"},
+				{"3-2", "영어 회화", ptr(4.0), nil, "A"},  // 등급 12 는 범위를 벗어나 비웁니다
+				{"3-2", "기하", ptr(3.0), nil, ""},      // 값 없이 비고만 있는 행
+				{"3-1", "고급 물리학", ptr(3.0), nil, "A"}, // 교과 이름에 공백("과학 계열")
+			},
+			warnRows: []int{9, 13, 14},
+		},
+		{
+			sample:  "neis-2022-pdf",
+			version: "2022",
+			counts:  map[string]int{"1-1": 7, "1-2": 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sample, func(t *testing.T) {
+			text, err := os.ReadFile(filepath.Join(naesinSamplesDir, tt.sample+".txt"))
+			require.NoError(t, err)
+			expected, err := os.ReadFile(filepath.Join(naesinSamplesDir, tt.sample+".json"))
+			require.NoError(t, err)
+
+			result, err := ImportNaesinFile(text, catalog, tt.version)
+			require.NoError(t, err)
+			assert.Equal(t, "neis", result.Format)
+			assert.Equal(t, tt.version, result.CurriculumVersion)
+
+			counts := map[string]int{}
+			for semester, subjects := range result.Naesin {
+				counts[semester] = len(subjects)
+			}
+			assert.Equal(t, tt.counts, counts)
+			for _, want := range tt.subjects {
+				got := findImported(t, result.Naesin, want.semester, want.name)
+				assert.Equal(t, want.credits, got.Credits, want.name)
+				assert.Equal(t, want.grade, got.Grade, want.name)
+				if want.level == "" {
+					assert.Nil(t, got.AchievementLevel, want.name)
+				} else if assert.NotNil(t, got.AchievementLevel, want.name) {
+					assert.Equal(t, want.level, *got.AchievementLevel, want.name)
+				}
+			}
+			var rows []int
+			for _, w := range result.Warnings {
+				rows = append(rows, w.Row)
+			}
+			assert.Equal(t, tt.warnRows, rows)
+
+			// 나머지 값(과목 코드, 원점수, 분포비율 등)은 기대 결과 파일과 통째로 비교합니다.
+			actual, err := json.Marshal(result)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expected), string(actual))
+		})
+	}
+}
+
+// 예시 디렉터리의 모든 파일이 위의 표에 있어야 합니다. (새 예시를 넣고 테스트를 빠뜨리지 않도록)
+func TestNEISSamplesAreCovered(t *testing.T) {
+	entries, err := os.ReadDir(naesinSamplesDir)
+	require.NoError(t, err)
+	var samples []string
+	for _, e := range entries {
+		if name, ok := strings.CutSuffix(e.Name(), ".txt"); ok {
+			samples = append(samples, name)
+			assert.FileExists(t, filepath.Join(naesinSamplesDir, name+".json"))
+		}
+	}
+	assert.Equal(t, []string{"neis-2015-pdf", "neis-2015-web-tabs", "neis-2022-pdf"}, samples)
+}
+
+func TestParseNEISTextMalformedRows(t *testing.T) {
+	catalog := testSubjectCatalog(t)
+	const header = "학기 교과 과목 단위수 원점수/과목평균 (표준편차) 성취도 (수강자수) 석차등급 비고"
+
+	tests := []struct {
+		name     string
+		lines    []string
+		wantRows map[int][]string // 줄 번호(표의 행 번호 + 1) -> 학년, 학기, 교과구분종류, 교과, 과목, 단위수, 원점수, 과목평균, 표준편차, 성취도, 수강자수, 석차등급
+		warnRows []int
+	}{
+		{
+			name:  "학년 줄 없음",
+			lines: []string{header, "1 국어 국어 4 92/71.3 (15.2) A (245) 2"},
+		},
+		{
+			name:     "단위수 없음",
+			lines:    []string{"[1학년]", header, "1 국어 국어 P", "1 수학 수학 4 85/62.1 (20.4) B (245) 3"},
+			wantRows: map[int][]string{4: {"1", "1", "", "수학", "수학", "4", "85", "62.1", "20.4", "B", "245", "3"}},
+			warnRows: []int{3},
+		},
+		{
+			name:     "쪽 번호와 머리말, 세부능력 및 특기사항은 건너뜀",
+			lines:    []string{"[1학년]", header, "- 2 / 7 -", "2 국어 국어 4 94/70.8 (14.9) A (243) 1", "세부능력 및 특기사항", "1 학기에 읽은 소설 4 편을 발표함"},
+			wantRows: map[int][]string{4: {"1", "2", "", "국어", "국어", "4", "94", "70.8", "14.9", "A", "243", "1"}},
+		},
+		{
+			name:  "값이 빠진 행과 비고",
+			lines: []string{"1학년", header, "1 과학 과학탐구실험 1 P", "2 수학 기하 3 수강 취소"},
+			wantRows: map[int][]string{
+				3: {"1", "1", "", "과학", "과학탐구실험", "1", "", "", "", "", "", ""},
+				4: {"1", "2", "", "수학", "기하", "3", "", "", "", "", "", ""},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, warnings, err := parseNEISText(strings.Join(tt.lines, "\n"), catalog)
+			if len(tt.wantRows) == 0 {
+				// 과목 행이 하나도 없으면 에러입니다.
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, neisTableHeader, rows[0])
+			got := map[int][]string{}
+			for i, row := range rows {
+				if i > 0 && row != nil {
+					got[i+1] = row[:12]
+				}
+			}
+			assert.Equal(t, tt.wantRows, got)
+			var warnRows []int
+			for _, w := range warnings {
+				warnRows = append(warnRows, w.Row)
+			}
+			assert.Equal(t, tt.warnRows, warnRows)
+		})
+	}
+
+	t.Run("헤더 줄 없음", func(t *testing.T) {
+		_, _, err := parseNEISText("[1학년]\n1 국어 국어 4 92/71.3 (15.2) A (245) 2", catalog)
+		assert.Error(t, err)
+	})
+}
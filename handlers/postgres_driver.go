@@ -0,0 +1,7 @@
+//go:build postgres
+
+package handlers
+
+// PostgreSQL 드라이버는 `go build -tags postgres` 로 빌드할 때만 포함됩니다.
+// (기본 빌드는 SQLite만 사용하므로 lib/pq 의존성이 필요 없습니다.)
+import _ "github.com/lib/pq"
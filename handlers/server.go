@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+	"univ/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- API 서버 ---
+// 핸들러가 쓰는 상태(저장소, 입시 데이터 스냅샷, 로드 리포트, 계산기 레지스트리)는 모두 Server 가 가집니다.
+// 패키지 전역 상태가 없으므로 한 프로세스에서 설정이 다른 서버를 여러 개 띄우거나,
+// 테스트에서 fixture 데이터로 만든 서버를 따로 쓸 수 있습니다.
+
+// AdmissionSource 는 입시 결과/학과 조회에 사용할 데이터 출처입니다.
+type AdmissionSource string
+
+const (
+	AdmissionSourceCSV AdmissionSource = "csv" // CSV를 메모리에 로드한 스냅샷 (기본값)
+	AdmissionSourceDB  AdmissionSource = "db"  // `univ import` 로 적재한 DB 테이블
+)
+
+// ServerOptions 는 NewServer 의 설정입니다. 빈 값은 기본값을 사용합니다.
+type ServerOptions struct {
+	Config          *config.Config      // CORS, 관리자 계정, GET /api/admin/config 에 사용. 기본값 config.Default()
+	Store           *Store              // 대학/학과/입시 결과 등을 조회할 저장소
+	AdmissionSource AdmissionSource     // 기본값 AdmissionSourceCSV
+	CSVSpecs        *CSVSpecs           // 기본값 DefaultCSVSpecs
+	Thresholds      LoadThresholds      // 다시 로드할 때 적용할 기준값
+	Calculators     *CalculatorRegistry // 기본값 NewCalculatorRegistry()
+	Logger          *slog.Logger        // 기본값 slog.Default()
+	Metrics         *Metrics            // 기본값 NewMetrics(). 서버마다 따로 만들어야 합니다.
+}
+
+type Server struct {
+	config      *config.Config
+	store       *Store
+	calculators *CalculatorRegistry
+	csvSpecs    CSVSpecs
+	logger      *slog.Logger
+	metrics     *Metrics
+
+	// 입시 결과/학과 조회 (AdmissionSource 에 따라 store 또는 스냅샷)
+	admissions  AdmissionRepository
+	departments DepartmentRepository
+
+	// 입시 데이터 스냅샷
+	dataset        atomic.Pointer[AdmissionDataset]
+	datasetVersion atomic.Int64
+
+	// 다시 로드할 때 사용할 원본 파일 경로와 기준값 (LoadAdmissionData / SetLoadThresholds 에서 설정)
+	dataSourceMu        sync.Mutex
+	departmentInfoFile  string
+	admissionResultFile string
+	reloadThresholds    LoadThresholds
+
+	lastLoadReportMu sync.RWMutex
+	lastLoadReport   *AdmissionLoadReport
+
+	// 과목 목록 (버전이 바뀌면 SubjectCatalog 에서 다시 읽음)
+	subjectCatalog atomic.Pointer[SubjectCatalog]
+
+	shuttingDown atomic.Bool
+}
+
+// NewServer 는 opts 로 서버를 만듭니다. 입시 데이터는 LoadAdmissionData 또는 LoadRecords 로 따로 로드해야 합니다.
+func NewServer(opts ServerOptions) *Server {
+	s := &Server{
+		config:           opts.Config,
+		calculators:      opts.Calculators,
+		logger:           opts.Logger,
+		metrics:          opts.Metrics,
+		csvSpecs:         DefaultCSVSpecs,
+		reloadThresholds: opts.Thresholds,
+	}
+	if s.config == nil {
+		s.config = config.Default()
+	}
+	if s.calculators == nil {
+		s.calculators = NewCalculatorRegistry()
+	}
+	if s.logger == nil {
+		s.logger = slog.Default()
+	}
+	if s.metrics == nil {
+		s.metrics = NewMetrics()
+	}
+	// 저장소 조회 시간을 /metrics 에 기록하도록 감쌉니다.
+	s.store = s.metrics.instrumentStore(opts.Store)
+	if opts.CSVSpecs != nil {
+		s.csvSpecs = *opts.CSVSpecs
+	}
+
+	if opts.AdmissionSource == AdmissionSourceDB && s.store != nil {
+		s.admissions = s.store.Admissions
+		s.departments = s.store.Departments
+	} else {
+		s.admissions = DatasetAdmissionRepository{Dataset: s.CurrentDataset}
+		s.departments = DatasetDepartmentRepository{Dataset: s.CurrentDataset}
+	}
+	s.metrics.registerDatasetGauges(s)
+	return s
+}
+
+// NewFixtureServer 는 테스트용 서버를 만듭니다.
+// 마이그레이션을 적용한 인메모리 SQLite 저장소를 쓰고, 입시 데이터는 records 스냅샷으로 채웁니다.
+func NewFixtureServer(records []AdmissionResult) (*Server, error) {
+	store, err := OpenStore("sqlite", ":memory:")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := store.Migrate(); err != nil {
+		store.Close()
+		return nil, err
+	}
+	s := NewServer(ServerOptions{Store: store})
+	s.LoadRecords(records)
+	return s, nil
+}
+
+// Store 는 서버가 사용하는 저장소를 반환합니다.
+func (s *Server) Store() *Store {
+	return s.store
+}
+
+// NewCalculator 는 서버의 계산기 레지스트리로 ScoreCalculator 를 만듭니다.
+// 계산 단계 로그는 ctx 의 요청 로거(request_id 포함)로 남깁니다.
+func (s *Server) NewCalculator(ctx context.Context, gpaScores []GpaScore, csatScores map[string]CsatScore) *ScoreCalculator {
+	sc := s.calculators.NewCalculator(gpaScores, csatScores).WithLogger(LoggerFrom(ctx))
+	sc.metrics = s.metrics
+	return sc
+}
+
+// Router 는 모든 API 경로가 등록된 gin 엔진을 만듭니다.
+func (s *Server) Router() *gin.Engine {
+	r := gin.New()
+	r.Use(RequestID(s.logger), AccessLog(), s.metrics.HTTPMiddleware(), Recovery())
+	cors := s.config.CORS
+	r.Use(CORS(CORSPolicy{
+		AllowedOrigins:   cors.AllowedOrigins,
+		AllowedHeaders:   cors.AllowedHeaders,
+		AllowCredentials: cors.AllowCredentials,
+		MaxAge:           time.Duration(cors.MaxAge),
+	}, r.Routes))
+
+	r.GET("/healthz", s.Healthz)
+	r.GET("/readyz", s.Readyz)
+	r.GET("/metrics", s.MetricsHandler)
+
+	api := r.Group("/api")
+	{
+		api.POST("/universities/filter", s.FilterUniversities)
+		api.GET("/subjects", s.Subject)
+		api.GET("/subjects/resolve", s.ResolveSubjects)
+		api.POST("/naesin/import", s.ImportNaesinGrades)
+		api.GET("/map/initial-data", s.GetUniversitiesHandler)
+		api.GET("/universities/:universityId/sidebar-details", s.SidebarDetails)
+	}
+
+	// /api/admin 은 관리자 계정(admin.username / admin.password)으로 Basic 인증을 요구합니다.
+	// 계정을 설정하지 않으면 열어 두지 않고 모든 요청을 403 으로 거절합니다.
+	admin := api.Group("/admin")
+	if creds := s.config.Admin; creds.Enabled() {
+		admin.Use(basicAuth(creds.Username, creds.Password))
+	} else {
+		admin.Use(adminDisabled)
+	}
+	{
+		admin.GET("/config", s.GetConfig)
+		admin.GET("/load-report", s.GetLoadReport)
+		admin.POST("/reload-data", s.ReloadDataHandler)
+	}
+
+	// 경로가 없거나 메소드가 맞지 않는 요청도 공통 에러 형식으로 응답합니다.
+	r.HandleMethodNotAllowed = true
+	r.NoRoute(routeNotFound)
+	r.NoMethod(methodNotAllowed)
+	return r
+}
+
+// GetConfig 핸들러는 현재 서버 설정을 반환합니다. 비밀번호 등 민감한 값은 가립니다. (관리자용)
+// GET /api/admin/config
+func (s *Server) GetConfig(c *gin.Context) {
+	c.JSON(http.StatusOK, s.config.Redacted())
+}
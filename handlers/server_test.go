@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+// fixtureRecords 는 핸들러 테스트에 쓰는 입시 결과입니다.
+func fixtureRecords() []AdmissionResult {
+	seoul := &Location{Latitude: 37.5, Longitude: 127.0}
+	return []AdmissionResult{
+		{UniversityName: "가나대학교", Campus: "서울", DepartmentName: "컴퓨터공학과", DepartmentCode: "C001", Region: "서울",
+			AdmissionType: "학생부교과", DetailAdmissionType: "학교장추천", CompetitionRate: ptr(10.0), Cut50: ptr(1.8), Cut70: ptr(2.0), Location: seoul},
+		{UniversityName: "가나대학교", Campus: "서울", DepartmentName: "컴퓨터공학과", DepartmentCode: "C001", Region: "서울",
+			AdmissionType: "수능", DetailAdmissionType: "일반전형", CompetitionRate: ptr(4.0), Cut70: ptr(2.2), Location: seoul},
+		{UniversityName: "다라대학교", Campus: "", DepartmentName: "경영학과", DepartmentCode: "B001", Region: "부산",
+			AdmissionType: "학생부종합", DetailAdmissionType: "활동우수자", Cut70: ptr(4.5)},
+		{UniversityName: "다라대학교", Campus: "", DepartmentName: "소프트웨어학과", DepartmentCode: "C001", Region: "부산",
+			AdmissionType: "학생부교과", DetailAdmissionType: "일반고", Cut70: ptr(5.0)},
+	}
+}
+
+// newFixtureRouter 는 fixture 입시 결과로 만든 서버의 라우터를 반환합니다.
+func newFixtureRouter(t *testing.T, records []AdmissionResult) *gin.Engine {
+	t.Helper()
+	server, err := NewFixtureServer(records)
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Store().Close() })
+	return server.Router()
+}
+
+// serve 는 요청 하나를 router 로 처리합니다. body 가 string 이 아니면 JSON 으로 인코딩합니다.
+func serve(t *testing.T, router http.Handler, method, path string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case string:
+		reader = bytes.NewBufferString(b)
+	default:
+		data, err := json.Marshal(b)
+		require.NoError(t, err)
+		reader = bytes.NewReader(data)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	if reader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// decodeJSON 은 응답 본문을 v 로 읽습니다.
+func decodeJSON(t *testing.T, w *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), v), w.Body.String())
+}
+
+// requireAPIError 는 응답이 공통 에러 형식이고 status/code 가 맞는지 확인한 뒤 에러를 반환합니다.
+func requireAPIError(t *testing.T, w *httptest.ResponseRecorder, status int, code string) APIError {
+	t.Helper()
+	require.Equal(t, status, w.Code, w.Body.String())
+	var body ErrorResponse
+	decodeJSON(t, w, &body)
+	require.Equal(t, code, body.Error.Code, w.Body.String())
+	assert.NotEmpty(t, body.Error.Message)
+	return body.Error
+}
+
+func TestErrorEnvelope(t *testing.T) {
+	router := newFixtureRouter(t, nil)
+	router.GET("/test-panic", func(c *gin.Context) { panic("boom") })
+
+	t.Run("등록되지 않은 경로", func(t *testing.T) {
+		w := serve(t, router, http.MethodGet, "/api/no-such-route", nil)
+		apiErr := requireAPIError(t, w, http.StatusNotFound, ErrCodeRouteNotFound)
+		assert.Equal(t, map[string]any{"path": "/api/no-such-route"}, apiErr.Details)
+	})
+	t.Run("메소드 불일치", func(t *testing.T) {
+		w := serve(t, router, http.MethodGet, "/api/universities/filter", nil)
+		requireAPIError(t, w, http.StatusMethodNotAllowed, ErrCodeMethodNotAllowed)
+		assert.Contains(t, w.Header().Get("Allow"), http.MethodPost)
+	})
+	t.Run("panic 은 원인을 숨긴 500", func(t *testing.T) {
+		w := serve(t, router, http.MethodGet, "/test-panic", nil)
+		requireAPIError(t, w, http.StatusInternalServerError, ErrCodeInternal)
+		assert.NotContains(t, w.Body.String(), "boom")
+	})
+	t.Run("요청 ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/no-such-route", nil)
+		req.Header.Set("X-Request-ID", "test-request-1")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, "test-request-1", w.Header().Get("X-Request-ID"))
+	})
+}
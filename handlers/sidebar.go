@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- 사이드바 상세 정보 응답 구조체 ---
+
+type SidebarItem struct {
+	Label string      `json:"label"`
+	Value interface{} `json:"value"`
+	Link  string      `json:"link,omitempty"`
+	Type  string      `json:"type,omitempty"`
+}
+
+type SidebarSection struct {
+	SectionTitle       string        `json:"sectionTitle"`
+	AdmissionProgramID string        `json:"admissionProgramId,omitempty"`
+	IsHighlighted      bool          `json:"isHighlighted"`
+	Items              []SidebarItem `json:"items"`
+	Notes              []string      `json:"notes,omitempty"`
+}
+
+type UniversitySidebarDetails struct {
+	UniversityID    string           `json:"universityId"`
+	UniversityName  string           `json:"universityName"`
+	CampusID        string           `json:"campusId"`
+	DepartmentID    string           `json:"departmentId"`
+	DepartmentName  string           `json:"departmentName"`
+	SidebarSections []SidebarSection `json:"sidebarSections"`
+}
+
+// SidebarDetails 핸들러는 대학 ID(경로)와 학과 ID(쿼리)로 학과의 전형별 작년도 결과를 반환합니다.
+// GET /api/universities/:universityId/sidebar-details?departmentId=...&admissionProgramId=...&admissionTypeFilter=...
+func (s *Server) SidebarDetails(c *gin.Context) {
+	universityID := c.Param("universityId")
+	departmentID := c.Query("departmentId")
+	programID := c.Query("admissionProgramId")            // Optional: 강조할 전형
+	admissionTypeFilter := c.Query("admissionTypeFilter") // Optional: '경쟁률' | '수능' | '종합' | '교과'
+
+	if departmentID == "" {
+		abortWithError(c, http.StatusBadRequest, ErrCodeInvalidParameter, "departmentId 파라미터가 필요합니다.", gin.H{"parameter": "departmentId"})
+		return
+	}
+
+	department, err := s.departments.FindDepartment(departmentID)
+	if err != nil {
+		abortWithInternalError(c, "학과 조회 중 에러가 발생했습니다.", err)
+		return
+	}
+	if department == nil || department.UniversityID != universityID {
+		abortWithError(c, http.StatusNotFound, ErrCodeNotFound, "해당 대학/학과 정보를 찾을 수 없습니다.",
+			gin.H{"universityId": universityID, "departmentId": departmentID})
+		return
+	}
+
+	records, err := s.admissions.FindAdmissions(AdmissionQuery{UniversityID: universityID, DepartmentID: departmentID})
+	if err != nil {
+		abortWithInternalError(c, "입시 결과 조회 중 에러가 발생했습니다.", err)
+		return
+	}
+
+	details := UniversitySidebarDetails{
+		UniversityID:    department.UniversityID,
+		UniversityName:  department.UniversityName,
+		CampusID:        department.CampusID,
+		DepartmentID:    department.ID,
+		DepartmentName:  department.Name,
+		SidebarSections: []SidebarSection{},
+	}
+	for _, record := range records {
+		highlighted := record.AdmissionProgramID == programID
+		if programID == "" && admissionTypeFilter != "" && admissionTypeFilter != "경쟁률" {
+			highlighted = strings.Contains(record.AdmissionType, admissionTypeFilter)
+		}
+
+		details.SidebarSections = append(details.SidebarSections, SidebarSection{
+			SectionTitle:       sidebarSectionTitle(record),
+			AdmissionProgramID: record.AdmissionProgramID,
+			IsHighlighted:      highlighted,
+			Items:              sidebarItems(record),
+		})
+	}
+
+	c.JSON(http.StatusOK, details)
+}
+
+func sidebarSectionTitle(record AdmissionResult) string {
+	if record.DetailAdmissionType != "" {
+		return fmt.Sprintf("%s (%s)", record.DetailAdmissionType, record.AdmissionType)
+	}
+	return record.AdmissionType
+}
+
+func sidebarItems(record AdmissionResult) []SidebarItem {
+	items := []SidebarItem{}
+	if record.CompetitionRate != nil {
+		items = append(items, SidebarItem{Label: "경쟁률", Value: *record.CompetitionRate})
+	}
+	if record.Cut50 != nil {
+		items = append(items, SidebarItem{Label: "작년 50%컷", Value: *record.Cut50})
+	}
+	if record.Cut70 != nil {
+		items = append(items, SidebarItem{Label: "작년 70%컷", Value: *record.Cut70})
+	}
+	return items
+}
@@ -0,0 +1,433 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"univ/migrations"
+)
+
+// --- SQL(SQLite / PostgreSQL) Repository 구현 ---
+// 두 DB는 같은 스키마(migrations/sqlite, migrations/postgres)를 쓰므로 구현을 공유하고,
+// 자리표시자("?" / "$n")와 일부 함수만 dialect 에 따라 바꿉니다.
+
+type sqlUniversityRepository struct {
+	db      *sql.DB
+	dialect migrations.Dialect
+}
+
+func (r sqlUniversityRepository) ListUniversities() ([]University, error) {
+	rows, err := r.db.Query("SELECT id, name, latitude, longitude FROM universities ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("대학 목록 조회 실패: %w", err)
+	}
+	defer rows.Close()
+
+	universities := make([]University, 0)
+	for rows.Next() {
+		var rowID string
+		var u University
+		var lat, lon sql.NullFloat64
+		if err := rows.Scan(&rowID, &u.Name, &lat, &lon); err != nil {
+			return nil, fmt.Errorf("대학 행 스캔 실패: %w", err)
+		}
+		// universities.id 는 "대학명+캠퍼스명" 형태(예: "가야대학교본교(제1캠퍼스)")이므로
+		// 대학명을 떼어낸 나머지를 캠퍼스명으로 보고 안정적인 ID를 계산합니다.
+		u.CampusName = strings.TrimSpace(strings.TrimPrefix(rowID, u.Name))
+		u.ID = UniversityID(u.Name)
+		u.CampusID = CampusID(u.Name, u.CampusName)
+		if lat.Valid && lon.Valid {
+			u.Location = &Location{Latitude: lat.Float64, Longitude: lon.Float64}
+		}
+		universities = append(universities, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("대학 목록 조회 중 에러: %w", err)
+	}
+	return universities, nil
+}
+
+type sqlDepartmentRepository struct {
+	db      *sql.DB
+	dialect migrations.Dialect
+}
+
+func (r sqlDepartmentRepository) FindDepartment(id string) (*Department, error) {
+	var d Department
+	err := r.db.QueryRow(r.dialect.Bind(`
+SELECT id, university_id, campus_id, university_name, campus, name, department_code, region
+FROM departments WHERE id = ?`), id).Scan(
+		&d.ID, &d.UniversityID, &d.CampusID, &d.UniversityName, &d.Campus, &d.Name, &d.DepartmentCode, &d.Region)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("학과 조회 실패: %w", err)
+	}
+	return &d, nil
+}
+
+type sqlAdmissionRepository struct {
+	db      *sql.DB
+	dialect migrations.Dialect
+}
+
+// 대학 위치는 universities 테이블(캠퍼스 단위)에서 대학명이 같은 첫 번째(id 순) 캠퍼스의 좌표를 사용합니다.
+const admissionSelect = `
+SELECT ar.id, d.id, d.university_id, d.campus_id,
+       d.university_name, d.campus, d.name, d.department_code, d.region,
+       ar.admission_type, ar.detail_admission_type, ar.competition_rate, ar.cut50, ar.cut70,
+       u.latitude, u.longitude
+FROM admission_rules ar
+JOIN departments d ON d.id = ar.department_id
+LEFT JOIN (%s) u ON u.name = d.university_name`
+
+const (
+	sqliteFirstCampusLocation = `
+    SELECT name, MIN(id) AS first_id, latitude, longitude
+    FROM universities
+    WHERE latitude IS NOT NULL AND longitude IS NOT NULL
+    GROUP BY name`
+	postgresFirstCampusLocation = `
+    SELECT DISTINCT ON (name) name, latitude, longitude
+    FROM universities
+    WHERE latitude IS NOT NULL AND longitude IS NOT NULL
+    ORDER BY name, id`
+)
+
+func (r sqlAdmissionRepository) FindAdmissions(q AdmissionQuery) ([]AdmissionResult, error) {
+	var where []string
+	var args []interface{}
+	if q.UniversityID != "" {
+		where = append(where, "d.university_id = ?")
+		args = append(args, q.UniversityID)
+	}
+	if q.DepartmentID != "" {
+		where = append(where, "d.id = ?")
+		args = append(args, q.DepartmentID)
+	}
+	if q.DepartmentCode != "" {
+		where = append(where, "d.department_code = ?")
+		args = append(args, q.DepartmentCode)
+	}
+	if q.Region != "" {
+		where = append(where, "d.region = ?")
+		args = append(args, q.Region)
+	}
+	if q.AdmissionTypeContains != "" {
+		if r.dialect == migrations.Postgres {
+			where = append(where, "strpos(ar.admission_type, ?) > 0")
+		} else {
+			where = append(where, "instr(ar.admission_type, ?) > 0")
+		}
+		args = append(args, q.AdmissionTypeContains)
+	}
+
+	locations := sqliteFirstCampusLocation
+	if r.dialect == migrations.Postgres {
+		locations = postgresFirstCampusLocation
+	}
+	query := fmt.Sprintf(admissionSelect, locations)
+	if len(where) > 0 {
+		query += "\nWHERE " + strings.Join(where, " AND ")
+	}
+	query += "\nORDER BY d.university_name, d.name, ar.admission_type, ar.detail_admission_type"
+
+	rows, err := r.db.Query(r.dialect.Bind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("입시 결과 조회 실패: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]AdmissionResult, 0)
+	for rows.Next() {
+		var rec AdmissionResult
+		var rate, cut50, cut70, lat, lon sql.NullFloat64
+		if err := rows.Scan(
+			&rec.AdmissionProgramID, &rec.DepartmentID, &rec.UniversityID, &rec.CampusID,
+			&rec.UniversityName, &rec.Campus, &rec.DepartmentName, &rec.DepartmentCode, &rec.Region,
+			&rec.AdmissionType, &rec.DetailAdmissionType, &rate, &cut50, &cut70,
+			&lat, &lon,
+		); err != nil {
+			return nil, fmt.Errorf("입시 결과 행 스캔 실패: %w", err)
+		}
+		rec.CompetitionRate = nullFloatPtr(rate)
+		rec.Cut50 = nullFloatPtr(cut50)
+		rec.Cut70 = nullFloatPtr(cut70)
+		if lat.Valid && lon.Valid {
+			rec.Location = &Location{Latitude: lat.Float64, Longitude: lon.Float64}
+		}
+		results = append(results, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("입시 결과 조회 중 에러: %w", err)
+	}
+	return results, nil
+}
+
+func (r sqlAdmissionRepository) CountAdmissions() (int, error) {
+	var n int
+	if err := r.db.QueryRow("SELECT COUNT(*) FROM admission_rules").Scan(&n); err != nil {
+		return 0, fmt.Errorf("입시 결과 수 조회 실패: %w", err)
+	}
+	return n, nil
+}
+
+type sqlSchemeRepository struct {
+	db      *sql.DB
+	dialect migrations.Dialect
+}
+
+func (r sqlSchemeRepository) FindSchemes(admissionProgramID string) ([]CalculationScheme, error) {
+	rows, err := r.db.Query(r.dialect.Bind(
+		"SELECT admission_program_id, id, scheme_json FROM calculation_schemes WHERE admission_program_id = ? ORDER BY id"), admissionProgramID)
+	if err != nil {
+		return nil, fmt.Errorf("계산 스키마 조회 실패: %w", err)
+	}
+	schemes := make([]CalculationScheme, 0)
+	err = scanSchemes(rows, func(_ string, scheme CalculationScheme) { schemes = append(schemes, scheme) })
+	if err != nil {
+		return nil, err
+	}
+	return schemes, nil
+}
+
+// schemeBatchSize 는 FindSchemesByPrograms 가 쿼리 하나에 넣는 전형 ID 수입니다. (SQLite 자리표시자 수 제한보다 작게)
+const schemeBatchSize = 500
+
+func (r sqlSchemeRepository) FindSchemesByPrograms(admissionProgramIDs []string) (map[string][]CalculationScheme, error) {
+	schemes := map[string][]CalculationScheme{}
+	for batch := range slices.Chunk(admissionProgramIDs, schemeBatchSize) {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(batch)), ", ")
+		args := make([]any, len(batch))
+		for i, id := range batch {
+			args[i] = id
+		}
+		rows, err := r.db.Query(r.dialect.Bind(
+			"SELECT admission_program_id, id, scheme_json FROM calculation_schemes WHERE admission_program_id IN ("+placeholders+") ORDER BY admission_program_id, id"), args...)
+		if err != nil {
+			return nil, fmt.Errorf("계산 스키마 조회 실패: %w", err)
+		}
+		err = scanSchemes(rows, func(programID string, scheme CalculationScheme) {
+			schemes[programID] = append(schemes[programID], scheme)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return schemes, nil
+}
+
+// scanSchemes 는 (admission_program_id, id, scheme_json) 행을 읽어 add 에 넘기고 rows 를 닫습니다.
+func scanSchemes(rows *sql.Rows, add func(programID string, scheme CalculationScheme)) error {
+	defer rows.Close()
+	for rows.Next() {
+		var programID, id, body string
+		if err := rows.Scan(&programID, &id, &body); err != nil {
+			return fmt.Errorf("계산 스키마 행 스캔 실패: %w", err)
+		}
+		var scheme CalculationScheme
+		if err := json.Unmarshal([]byte(body), &scheme); err != nil {
+			return fmt.Errorf("계산 스키마 %s 의 JSON 파싱 실패: %w", id, err)
+		}
+		add(programID, scheme)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("계산 스키마 조회 중 에러: %w", err)
+	}
+	return nil
+}
+
+type sqlGradeCutRepository struct {
+	db      *sql.DB
+	dialect migrations.Dialect
+}
+
+func (r sqlGradeCutRepository) FindGradeCuts(year, month int) (*ExamGradeCuts, error) {
+	rows, err := r.db.Query(r.dialect.Bind(`
+SELECT exam_name, subject_area, subject_name, cut_data_json
+FROM exam_grade_cuts WHERE exam_year = ? AND exam_month = ?
+ORDER BY subject_area, subject_name`), year, month)
+	if err != nil {
+		return nil, fmt.Errorf("등급컷 조회 실패: %w", err)
+	}
+	defer rows.Close()
+
+	var cuts *ExamGradeCuts
+	for rows.Next() {
+		var examName, area, subject, body string
+		if err := rows.Scan(&examName, &area, &subject, &body); err != nil {
+			return nil, fmt.Errorf("등급컷 행 스캔 실패: %w", err)
+		}
+		if cuts == nil {
+			cuts = &ExamGradeCuts{ExamYear: year, ExamMonth: month, ExamName: examName, Subjects: map[string]map[string]json.RawMessage{}}
+		}
+		if cuts.Subjects[area] == nil {
+			cuts.Subjects[area] = map[string]json.RawMessage{}
+		}
+		cuts.Subjects[area][subject] = json.RawMessage(body)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("등급컷 조회 중 에러: %w", err)
+	}
+	return cuts, nil
+}
+
+func (r sqlGradeCutRepository) CountGradeCuts() (int, error) {
+	var n int
+	if err := r.db.QueryRow("SELECT COUNT(*) FROM exam_grade_cuts").Scan(&n); err != nil {
+		return 0, fmt.Errorf("등급컷 수 조회 실패: %w", err)
+	}
+	return n, nil
+}
+
+type sqlSubjectRepository struct {
+	db      *sql.DB
+	dialect migrations.Dialect
+}
+
+func (r sqlSubjectRepository) SubjectCatalogVersion() (string, error) {
+	var version string
+	err := r.db.QueryRow("SELECT version FROM subject_catalog_versions ORDER BY id DESC LIMIT 1").Scan(&version)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("과목 목록 버전 조회 실패: %w", err)
+	}
+	return version, nil
+}
+
+func (r sqlSubjectRepository) LoadSubjectCatalog() (*SubjectCatalog, error) {
+	catalog := &SubjectCatalog{}
+	err := r.db.QueryRow("SELECT version, COALESCE(note, '') FROM subject_catalog_versions ORDER BY id DESC LIMIT 1").
+		Scan(&catalog.Version, &catalog.Note)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("과목 목록 버전 조회 실패: %w", err)
+	}
+
+	if err := r.scan("SELECT code, name, name_en, curriculum_version, admission_year_from, admission_year_to FROM subject_classifications ORDER BY display_order, code", func(rows *sql.Rows) error {
+		var class NaesinCurriculumClassification
+		var from, to sql.NullInt64
+		if err := rows.Scan(&class.Code, &class.Name, &class.NameEn, &class.CurriculumVersion, &from, &to); err != nil {
+			return err
+		}
+		class.AdmissionYearFrom, class.AdmissionYearTo = nullIntPtr(from), nullIntPtr(to)
+		catalog.Classifications = append(catalog.Classifications, class)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("교과구분종류 조회 실패: %w", err)
+	}
+
+	if err := r.scan("SELECT code, name, name_en, classification_code, curriculum_version, admission_year_from, admission_year_to FROM subject_curriculums ORDER BY display_order, classification_code, code", func(rows *sql.Rows) error {
+		var curr NaesinCurriculum
+		var from, to sql.NullInt64
+		if err := rows.Scan(&curr.Code, &curr.Name, &curr.NameEn, &curr.ClassificationCode, &curr.CurriculumVersion, &from, &to); err != nil {
+			return err
+		}
+		curr.AdmissionYearFrom, curr.AdmissionYearTo = nullIntPtr(from), nullIntPtr(to)
+		catalog.Curriculums = append(catalog.Curriculums, curr)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("교과 조회 실패: %w", err)
+	}
+
+	if err := r.scan("SELECT subject_type, parent_code, code, name, name_en, curriculum_version, admission_year_from, admission_year_to FROM subjects_master ORDER BY display_order, id", func(rows *sql.Rows) error {
+		var subjectType, parent, code, name, nameEn string
+		var a CurriculumApplicability
+		var from, to sql.NullInt64
+		if err := rows.Scan(&subjectType, &parent, &code, &name, &nameEn, &a.CurriculumVersion, &from, &to); err != nil {
+			return err
+		}
+		a.AdmissionYearFrom, a.AdmissionYearTo = nullIntPtr(from), nullIntPtr(to)
+		switch subjectType {
+		case "naesin":
+			catalog.NaesinSubjects = append(catalog.NaesinSubjects, NaesinRawSubject{Code: code, Name: name, NameEn: nameEn, CurriculumCode: parent, CurriculumApplicability: a})
+		case "suneung":
+			catalog.SuneungSubjects = append(catalog.SuneungSubjects, SuneungSubject{Code: code, Name: name, NameEn: nameEn, Area: parent, CurriculumApplicability: a})
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("과목 조회 실패: %w", err)
+	}
+
+	if err := r.scan("SELECT code, name, name_en, max_raw_score, absolute_grading, payload_key FROM suneung_areas ORDER BY display_order, code", func(rows *sql.Rows) error {
+		var area SuneungArea
+		var absolute int
+		if err := rows.Scan(&area.Code, &area.Name, &area.NameEn, &area.MaxRawScore, &absolute, &area.PayloadKey); err != nil {
+			return err
+		}
+		area.AbsoluteGrading = absolute != 0
+		catalog.SuneungAreas = append(catalog.SuneungAreas, area)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("수능 영역 조회 실패: %w", err)
+	}
+
+	if err := r.scan("SELECT code, name, name_en, rule_json, curriculum_version, admission_year_from, admission_year_to FROM suneung_selection_rules ORDER BY display_order, id", func(rows *sql.Rows) error {
+		var rule SuneungSelectionRule
+		var body string
+		var from, to sql.NullInt64
+		if err := rows.Scan(&rule.Code, &rule.Name, &rule.NameEn, &body, &rule.CurriculumVersion, &from, &to); err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(body), &rule); err != nil {
+			return fmt.Errorf("수능 선택 규칙 %q 의 rule_json 파싱 실패: %w", rule.Code, err)
+		}
+		rule.AdmissionYearFrom, rule.AdmissionYearTo = nullIntPtr(from), nullIntPtr(to)
+		catalog.SuneungRules = append(catalog.SuneungRules, rule)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("수능 선택 규칙 조회 실패: %w", err)
+	}
+
+	if err := r.scan("SELECT university_id, label, rule_json FROM subject_categories ORDER BY display_order, id", func(rows *sql.Rows) error {
+		var university, label, rule string
+		if err := rows.Scan(&university, &label, &rule); err != nil {
+			return err
+		}
+		var cat SubjectCategory
+		if err := json.Unmarshal([]byte(rule), &cat); err != nil {
+			return fmt.Errorf("교과 분류 %q 의 rule_json 파싱 실패: %w", label, err)
+		}
+		cat.UniversityID, cat.Label = university, label
+		catalog.Categories = append(catalog.Categories, cat)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("교과 분류 조회 실패: %w", err)
+	}
+	return catalog.prepare(), nil
+}
+
+// scan 은 query 의 행마다 fn 을 호출합니다.
+func (r sqlSubjectRepository) scan(query string, fn func(rows *sql.Rows) error) error {
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func nullFloatPtr(v sql.NullFloat64) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	f := v.Float64
+	return &f
+}
+
+func nullIntPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	n := int(v.Int64)
+	return &n
+}
@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMemoryStore 는 마이그레이션을 적용한 인메모리 SQLite 저장소를 만듭니다.
+func newMemoryStore(t *testing.T) *Store {
+	t.Helper()
+	store, err := OpenStore("sqlite", ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	_, err = store.Migrate()
+	require.NoError(t, err)
+	return store
+}
+
+// writeFile 은 t.TempDir() 아래에 파일을 만들고 경로를 반환합니다.
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+const (
+	storeTestDepartments = `학교명,주야간구분,학과명,학과코드
+가천대학교,주간,컴퓨터공학과,C001
+가천대학교,주간,경영학과,B001
+가천대학교,야간,경영학과,B002
+`
+	storeTestResults = `대학명,캠퍼스,모집단위,지역,전형유형,전형명,경쟁률,50%컷,70%컷
+가천대학교,본교(제1캠퍼스),컴퓨터공학과,경기,학생부교과,학생부우수자,12.5,2.1,2.5
+가천대학교,본교(제1캠퍼스),컴퓨터공학과,경기,수능,일반전형,5.1,-,
+가천대학교,본교(제1캠퍼스),경영학과,경기,학생부종합,가천바람개비,8,3.2,3.6
+가천대학교,본교(제1캠퍼스),경영학과,경기,실기,실기우수자,3,,
+`
+)
+
+func TestOpenStoreUnknownDriver(t *testing.T) {
+	_, err := OpenStore("mysql", "x")
+	assert.Error(t, err)
+}
+
+func TestOpenStorePostgresWithoutDriver(t *testing.T) {
+	if PostgresDriverAvailable() {
+		t.Skip("postgres 태그로 빌드하여 드라이버가 있습니다")
+	}
+	_, err := OpenStore("postgres", "postgres://localhost/univ")
+	assert.ErrorIs(t, err, errPostgresDriverMissing)
+}
+
+// 아래 testStore* 함수는 SQLite(여기)와 PostgreSQL(store_postgres_test.go)에서 같이 돌립니다.
+// store 는 마이그레이션을 적용한 빈 저장소입니다.
+
+func TestSQLiteUniversities(t *testing.T) {
+	testStoreUniversities(t, newMemoryStore(t))
+}
+
+func testStoreUniversities(t *testing.T, store *Store) {
+	universities, err := store.Universities.ListUniversities()
+	require.NoError(t, err)
+	require.NotEmpty(t, universities, "0002_seed_universities 가 대학을 넣어야 합니다")
+
+	var gachon []University
+	for _, u := range universities {
+		if u.Name == "가천대학교" {
+			gachon = append(gachon, u)
+		}
+	}
+	require.Len(t, gachon, 2)
+	assert.Equal(t, "본교(제1캠퍼스)", gachon[0].CampusName)
+	assert.Equal(t, UniversityID("가천대학교"), gachon[0].ID)
+	assert.Equal(t, gachon[0].ID, gachon[1].ID)
+	assert.NotEqual(t, gachon[0].CampusID, gachon[1].CampusID)
+	require.NotNil(t, gachon[0].Location)
+}
+
+func TestSQLiteAdmissionsAfterImport(t *testing.T) {
+	testStoreAdmissionsAfterImport(t, newMemoryStore(t))
+}
+
+func testStoreAdmissionsAfterImport(t *testing.T, store *Store) {
+	deptPath := writeFile(t, "departments.csv", storeTestDepartments)
+	resultPath := writeFile(t, "results.csv", storeTestResults)
+
+	report, err := ImportAdmissionData(store, DefaultCSVSpecs, deptPath, resultPath)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Loaded)
+	assert.Equal(t, 1, report.Admission.Skipped[SkipReasonUnsupportedAdmission])
+	assert.Equal(t, 1, report.Department.Skipped[SkipReasonNotDaytime])
+
+	n, err := store.Admissions.CountAdmissions()
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	all, err := store.Admissions.FindAdmissions(AdmissionQuery{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	// 대학명, 학과명, 전형 유형 순으로 정렬됩니다.
+	assert.Equal(t, "경영학과", all[0].DepartmentName)
+	assert.Equal(t, "B001", all[0].DepartmentCode)
+	for _, rec := range all {
+		assert.NotEmpty(t, rec.AdmissionProgramID)
+		require.NotNil(t, rec.Location, "대학 위치는 universities 테이블에서 가져옵니다")
+	}
+
+	csat, err := store.Admissions.FindAdmissions(AdmissionQuery{AdmissionTypeContains: "수능"})
+	require.NoError(t, err)
+	require.Len(t, csat, 1)
+	assert.Equal(t, "일반전형", csat[0].DetailAdmissionType)
+	require.NotNil(t, csat[0].CompetitionRate)
+	assert.Equal(t, 5.1, *csat[0].CompetitionRate)
+	assert.Nil(t, csat[0].Cut50, `"-" 는 값 없음입니다`)
+	assert.Nil(t, csat[0].Cut70)
+
+	byDept, err := store.Admissions.FindAdmissions(AdmissionQuery{DepartmentCode: "C001", Region: "경기"})
+	require.NoError(t, err)
+	assert.Len(t, byDept, 2)
+
+	none, err := store.Admissions.FindAdmissions(AdmissionQuery{UniversityID: "no-such-university"})
+	require.NoError(t, err)
+	assert.Empty(t, none)
+
+	dept, err := store.Departments.FindDepartment(csat[0].DepartmentID)
+	require.NoError(t, err)
+	require.NotNil(t, dept)
+	assert.Equal(t, "컴퓨터공학과", dept.Name)
+	assert.Equal(t, csat[0].UniversityID, dept.UniversityID)
+
+	missing, err := store.Departments.FindDepartment("no-such-department")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	// 다시 적재하면 기존 데이터를 교체합니다.
+	_, err = ImportAdmissionData(store, DefaultCSVSpecs, deptPath, writeFile(t, "results.csv",
+		"대학명,캠퍼스,모집단위,지역,전형유형,전형명,경쟁률,50%컷,70%컷\n가천대학교,본교(제1캠퍼스),경영학과,경기,수능,일반전형,4,,\n"))
+	require.NoError(t, err)
+	n, err = store.Admissions.CountAdmissions()
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestSQLiteImportKeepsDataOnCSVError(t *testing.T) {
+	testStoreImportKeepsDataOnCSVError(t, newMemoryStore(t))
+}
+
+func testStoreImportKeepsDataOnCSVError(t *testing.T, store *Store) {
+	deptPath := writeFile(t, "departments.csv", storeTestDepartments)
+	_, err := ImportAdmissionData(store, DefaultCSVSpecs, deptPath, writeFile(t, "results.csv", storeTestResults))
+	require.NoError(t, err)
+
+	_, err = ImportAdmissionData(store, DefaultCSVSpecs, deptPath, filepath.Join(t.TempDir(), "missing.csv"))
+	require.Error(t, err)
+	n, err := store.Admissions.CountAdmissions()
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
+
+func TestSQLiteSchemes(t *testing.T) {
+	testStoreSchemes(t, newMemoryStore(t))
+}
+
+func testStoreSchemes(t *testing.T, store *Store) {
+	_, err := store.DB.Exec(`INSERT INTO calculation_schemes (id, admission_program_id, admission_type, scheme_json) VALUES
+        ('s2', 'p1', '수능', '{"admission_type": "수능"}'),
+        ('s1', 'p1', '교과', '{"admission_type": "교과"}'),
+        ('s3', 'p2', '교과', '{"admission_type": "교과"}')`)
+	require.NoError(t, err)
+
+	schemes, err := store.Schemes.FindSchemes("p1")
+	require.NoError(t, err)
+	require.Len(t, schemes, 2)
+	assert.Equal(t, "교과", schemes[0].AdmissionType, "id 순서입니다")
+	assert.Equal(t, "수능", schemes[1].AdmissionType)
+
+	schemes, err = store.Schemes.FindSchemes("none")
+	require.NoError(t, err)
+	assert.Empty(t, schemes)
+
+	_, err = store.DB.Exec(`INSERT INTO calculation_schemes (id, admission_program_id, admission_type, scheme_json) VALUES ('bad', 'p3', '교과', '{')`)
+	require.NoError(t, err)
+	_, err = store.Schemes.FindSchemes("p3")
+	assert.Error(t, err)
+	_, err = store.Schemes.FindSchemesByPrograms([]string{"p1", "p3"})
+	assert.Error(t, err)
+}
+
+func TestSQLiteSchemesByPrograms(t *testing.T) {
+	testStoreSchemesByPrograms(t, newMemoryStore(t))
+}
+
+func testStoreSchemesByPrograms(t *testing.T, store *Store) {
+	_, err := store.DB.Exec(`INSERT INTO calculation_schemes (id, admission_program_id, admission_type, scheme_json) VALUES
+        ('s2', 'p1', '수능', '{"admission_type": "수능", "scheme_details": {"score_source": "CSAT"}}'),
+        ('s1', 'p1', '교과', '{"admission_type": "교과", "scheme_details": {"score_source": "GPA"}}'),
+        ('s3', 'p2', '교과', '{"admission_type": "교과", "scheme_details": {"score_source": "GPA"}}'),
+        ('s4', 'p1200', '교과', '{"admission_type": "교과", "scheme_details": {"score_source": "GPA"}}')`)
+	require.NoError(t, err)
+
+	schemes, err := store.Schemes.FindSchemesByPrograms([]string{"p1", "p2", "none"})
+	require.NoError(t, err)
+	require.Len(t, schemes, 2, "스키마가 없는 전형은 map 에 없습니다")
+	require.Len(t, schemes["p1"], 2)
+	assert.Equal(t, "교과", schemes["p1"][0].AdmissionType, "id 순서입니다")
+	assert.Equal(t, "수능", schemes["p1"][1].AdmissionType)
+	assert.Len(t, schemes["p2"], 1)
+
+	// 전형 ID가 많으면 나누어 조회합니다.
+	var many []string
+	for i := range 3 * schemeBatchSize {
+		many = append(many, fmt.Sprintf("p%d", i))
+	}
+	schemes, err = store.Schemes.FindSchemesByPrograms(many)
+	require.NoError(t, err)
+	assert.Len(t, schemes, 3)
+	assert.Contains(t, schemes, "p1200")
+
+	schemes, err = store.Schemes.FindSchemesByPrograms(nil)
+	require.NoError(t, err)
+	assert.Empty(t, schemes)
+}
+
+func TestSQLiteGradeCuts(t *testing.T) {
+	testStoreGradeCuts(t, newMemoryStore(t))
+}
+
+func testStoreGradeCuts(t *testing.T, store *Store) {
+	cuts, err := store.GradeCuts.FindGradeCuts(2024, 11)
+	require.NoError(t, err)
+	assert.Nil(t, cuts)
+
+	_, err = store.DB.Exec(`INSERT INTO exam_grade_cuts (exam_year, exam_month, exam_name, subject_area, subject_name, cut_data_json) VALUES
+        (2024, 11, '2025학년도 수능', '국어', '언어와 매체', '[{"raw": 100}]'),
+        (2024, 11, '2025학년도 수능', '국어', '화법과 작문', '[]'),
+        (2024, 6, '6월 모의평가', '수학', '미적분', '[]')`)
+	require.NoError(t, err)
+
+	cuts, err = store.GradeCuts.FindGradeCuts(2024, 11)
+	require.NoError(t, err)
+	require.NotNil(t, cuts)
+	assert.Equal(t, "2025학년도 수능", cuts.ExamName)
+	assert.Len(t, cuts.Subjects["국어"], 2)
+	assert.JSONEq(t, `[{"raw": 100}]`, string(cuts.Subjects["국어"]["언어와 매체"]))
+
+	n, err := store.GradeCuts.CountGradeCuts()
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
+
+func TestSQLiteSubjectCatalog(t *testing.T) {
+	testStoreSubjectCatalog(t, newMemoryStore(t))
+}
+
+func testStoreSubjectCatalog(t *testing.T, store *Store) {
+	version, err := store.Subjects.SubjectCatalogVersion()
+	require.NoError(t, err)
+	assert.NotEmpty(t, version)
+
+	catalog, err := store.Subjects.LoadSubjectCatalog()
+	require.NoError(t, err)
+	assert.NotEmpty(t, catalog.Classifications)
+	assert.NotEmpty(t, catalog.Curriculums)
+	assert.NotEmpty(t, catalog.NaesinSubjects)
+	assert.NotEmpty(t, catalog.SuneungSubjects)
+	assert.NotEmpty(t, catalog.SuneungAreas)
+	assert.Empty(t, catalog.Check(), "마이그레이션이 넣은 과목 목록은 일관성 검사를 통과해야 합니다")
+}
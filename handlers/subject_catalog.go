@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// --- 과목 목록 (SubjectCatalog) ---
+// 교과구분종류/교과/과목과 수능 선택과목 목록은 DB 테이블(migrations 0005_create_subject_catalog)에 있습니다.
+// 목록을 고칠 때는 `univ export-subjects` 로 JSON 파일을 내려받아 수정한 뒤 `univ import-subjects` 로 적재하면
+// 재컴파일/재배포 없이 반영됩니다. 서버는 버전이 바뀌면 다음 요청에서 목록을 다시 읽습니다.
+
+// SubjectCatalog 는 과목 목록 전체입니다. JSON 파일 형식(import-subjects / export-subjects)과 같습니다.
+type SubjectCatalog struct {
+	Version         string                           `json:"version"` // 적재할 때마다 새 값 (예: "2025-03")
+	Note            string                           `json:"note,omitempty"`
+	Classifications []NaesinCurriculumClassification `json:"classifications"`
+	Curriculums     []NaesinCurriculum               `json:"curriculums"`
+	NaesinSubjects  []NaesinRawSubject               `json:"naesinSubjects"`
+	SuneungSubjects []SuneungSubject                 `json:"suneungSubjects"`
+	SuneungAreas    []SuneungArea                    `json:"suneungAreas,omitempty"` // 수능 영역 (suneung_catalog.go)
+	SuneungRules    []SuneungSelectionRule           `json:"suneungRules,omitempty"` // 수능 과목 선택 규칙
+	Categories      []SubjectCategory                `json:"categories,omitempty"`   // 교과 분류 (subject_category.go)
+
+	codes      naesinCatalogCodes    // 검증용 코드 집합 (prepare 에서 만듦)
+	resolver   *subjectResolver      // 과목명 찾기 색인 (prepare 에서 만듦)
+	categories *subjectCategoryIndex // 과목 → 교과 분류 색인 (prepare 에서 만듦)
+}
+
+// prepare 는 비어 있는 과목 코드와 교육과정 버전, 수능 영역을 채우고 검증용 코드 집합, 과목명 찾기 색인, 교과 분류 색인을 만듭니다. 읽거나 파싱한 직후 한 번 호출합니다.
+func (c *SubjectCatalog) prepare() *SubjectCatalog {
+	c.codes = naesinCatalogCodes{classifications: map[string]string{}, curriculums: map[string]string{}, subjects: map[string]string{}}
+	for i := range c.Classifications {
+		class := &c.Classifications[i]
+		class.CurriculumApplicability = class.withDefaultVersion()
+		c.codes.classifications[class.Code] = class.CurriculumVersion
+	}
+	for i := range c.Curriculums {
+		curr := &c.Curriculums[i]
+		curr.CurriculumApplicability = curr.withDefaultVersion()
+		c.codes.curriculums[curr.Code] = curr.CurriculumVersion
+	}
+	for i := range c.NaesinSubjects {
+		subj := &c.NaesinSubjects[i]
+		if subj.Code == "" {
+			subj.Code = naesinSubjectCode(subj.Name)
+		}
+		subj.CurriculumApplicability = subj.withDefaultVersion()
+		c.codes.subjects[subj.Code] = subj.CurriculumVersion
+	}
+	for i := range c.SuneungSubjects {
+		subj := &c.SuneungSubjects[i]
+		if subj.Code == "" {
+			subj.Code = suneungSubjectCode(subj.Name)
+		}
+		subj.CurriculumApplicability = subj.withDefaultVersion()
+	}
+	if len(c.SuneungAreas) == 0 {
+		c.SuneungAreas = slices.Clone(defaultSuneungAreas)
+	}
+	for i := range c.SuneungRules {
+		rule := &c.SuneungRules[i]
+		rule.CurriculumApplicability = rule.withDefaultVersion()
+	}
+	c.resolver = newSubjectResolver(c)
+	c.categories = newSubjectCategoryIndex(c)
+	return c
+}
+
+// Validate 는 적재하기 전에 버전과 목록의 일관성(Check)을 확인합니다.
+func (c *SubjectCatalog) Validate() error {
+	var problems []string
+	if strings.TrimSpace(c.Version) == "" {
+		problems = append(problems, "version 이 비어 있습니다")
+	}
+	for _, issue := range c.Check() {
+		problems = append(problems, issue.String())
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("과목 목록이 올바르지 않습니다:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+	return nil
+}
+
+// ReadSubjectCatalogFile 은 JSON 과목 목록 파일을 읽습니다.
+func ReadSubjectCatalogFile(path string) (*SubjectCatalog, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var catalog SubjectCatalog
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&catalog); err != nil {
+		return nil, fmt.Errorf("과목 목록 파일 %s 파싱 실패: %w", path, err)
+	}
+	return catalog.prepare(), nil
+}
+
+// ImportSubjectCatalog 는 저장소의 과목 목록을 catalog 로 교체하고 버전을 기록합니다.
+// 전체 작업은 하나의 트랜잭션으로 처리되므로, 실패하면 기존 목록이 그대로 남습니다.
+func ImportSubjectCatalog(s *Store, catalog *SubjectCatalog) error {
+	catalog.prepare()
+	if err := catalog.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists int
+	if err := tx.QueryRow(s.Dialect.Bind("SELECT COUNT(*) FROM subject_catalog_versions WHERE version = ?"), catalog.Version).Scan(&exists); err != nil {
+		return err
+	}
+	if exists > 0 {
+		return fmt.Errorf("이미 적재한 버전입니다: %q (새 version 값을 지정하세요)", catalog.Version)
+	}
+
+	for _, table := range []string{"suneung_selection_rules", "suneung_areas", "subject_categories", "subjects_master", "subject_curriculums", "subject_classifications"} {
+		if _, err := tx.Exec("DELETE FROM " + table); err != nil {
+			return err
+		}
+	}
+
+	// 목록의 행을 순서대로 그대로 넣습니다. 코드 중복은 위의 Validate(duplicate_code)에서 이미 걸러지며,
+	// 그래도 남은 충돌은 테이블의 UNIQUE 제약에 걸려 트랜잭션 전체가 되돌려집니다.
+	inserts := []struct {
+		query string
+		rows  func(yield func(args ...interface{}) error) error
+	}{
+		{"INSERT INTO subject_classifications (code, name, name_en, display_order, curriculum_version, admission_year_from, admission_year_to) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			func(yield func(args ...interface{}) error) error {
+				for i, class := range catalog.Classifications {
+					a := class.CurriculumApplicability
+					if err := yield(class.Code, class.Name, class.NameEn, i+1, a.CurriculumVersion, a.AdmissionYearFrom, a.AdmissionYearTo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}},
+		{"INSERT INTO subject_curriculums (classification_code, code, name, name_en, display_order, curriculum_version, admission_year_from, admission_year_to) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+			func(yield func(args ...interface{}) error) error {
+				for i, curr := range catalog.Curriculums {
+					a := curr.CurriculumApplicability
+					if err := yield(curr.ClassificationCode, curr.Code, curr.Name, curr.NameEn, i+1, a.CurriculumVersion, a.AdmissionYearFrom, a.AdmissionYearTo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}},
+		{"INSERT INTO subjects_master (subject_type, parent_code, code, name, name_en, display_order, curriculum_version, admission_year_from, admission_year_to) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			func(yield func(args ...interface{}) error) error {
+				order := 0
+				for _, subj := range catalog.NaesinSubjects {
+					order++
+					a := subj.CurriculumApplicability
+					if err := yield("naesin", subj.CurriculumCode, subj.Code, subj.Name, subj.NameEn, order, a.CurriculumVersion, a.AdmissionYearFrom, a.AdmissionYearTo); err != nil {
+						return err
+					}
+				}
+				for _, subj := range catalog.SuneungSubjects {
+					order++
+					a := subj.CurriculumApplicability
+					if err := yield("suneung", subj.Area, subj.Code, subj.Name, subj.NameEn, order, a.CurriculumVersion, a.AdmissionYearFrom, a.AdmissionYearTo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}},
+		{"INSERT INTO suneung_areas (code, name, name_en, display_order, max_raw_score, absolute_grading, payload_key) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			func(yield func(args ...interface{}) error) error {
+				for i, area := range catalog.SuneungAreas {
+					absolute := 0
+					if area.AbsoluteGrading {
+						absolute = 1
+					}
+					if err := yield(area.Code, area.Name, area.NameEn, i+1, area.MaxRawScore, absolute, area.PayloadKey); err != nil {
+						return err
+					}
+				}
+				return nil
+			}},
+		{"INSERT INTO suneung_selection_rules (code, name, name_en, display_order, rule_json, curriculum_version, admission_year_from, admission_year_to) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+			func(yield func(args ...interface{}) error) error {
+				for i, rule := range catalog.SuneungRules {
+					body, err := suneungSelectionRuleJSON(rule)
+					if err != nil {
+						return err
+					}
+					a := rule.CurriculumApplicability
+					if err := yield(rule.Code, rule.Name, rule.NameEn, i+1, body, a.CurriculumVersion, a.AdmissionYearFrom, a.AdmissionYearTo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}},
+		{"INSERT INTO subject_categories (university_id, label, display_order, rule_json) VALUES (?, ?, ?, ?)",
+			func(yield func(args ...interface{}) error) error {
+				for i, cat := range catalog.Categories {
+					rule, err := subjectCategoryRuleJSON(cat)
+					if err != nil {
+						return err
+					}
+					if err := yield(cat.UniversityID, cat.Label, i+1, rule); err != nil {
+						return err
+					}
+				}
+				return nil
+			}},
+	}
+	for _, ins := range inserts {
+		stmt, err := tx.Prepare(s.Dialect.Bind(ins.query))
+		if err != nil {
+			return err
+		}
+		err = ins.rows(func(args ...interface{}) error {
+			_, err := stmt.Exec(args...)
+			return err
+		})
+		stmt.Close()
+		if err != nil {
+			return fmt.Errorf("과목 목록 적재 실패: %w", err)
+		}
+	}
+
+	if _, err := tx.Exec(s.Dialect.Bind("INSERT INTO subject_catalog_versions (version, note, imported_at) VALUES (?, ?, ?)"),
+		catalog.Version, catalog.Note, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SubjectCatalog 는 현재 과목 목록을 반환합니다.
+// 읽어 둔 목록을 재사용하되, 저장소의 버전이 바뀌었으면(import-subjects) 다시 읽습니다.
+func (s *Server) SubjectCatalog() (*SubjectCatalog, error) {
+	if s.store == nil || s.store.Subjects == nil {
+		return nil, errors.New("과목 목록 저장소가 설정되지 않았습니다")
+	}
+	version, err := s.store.Subjects.SubjectCatalogVersion()
+	if err != nil {
+		return nil, err
+	}
+	if cached := s.subjectCatalog.Load(); cached != nil && cached.Version == version {
+		return cached, nil
+	}
+
+	catalog, err := s.store.Subjects.LoadSubjectCatalog()
+	if err != nil {
+		return nil, err
+	}
+	s.subjectCatalog.Store(catalog)
+	s.logger.Info("과목 목록을 읽었습니다", "version", catalog.Version,
+		"classifications", len(catalog.Classifications), "curriculums", len(catalog.Curriculums),
+		"naesin_subjects", len(catalog.NaesinSubjects), "suneung_subjects", len(catalog.SuneungSubjects))
+	return catalog, nil
+}
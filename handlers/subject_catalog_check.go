@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// --- 과목 목록 일관성 검사 ---
+// 과목 목록은 여러 사람이 JSON 파일로 고쳐 적재하므로, 코드가 겹치거나 상위 코드가 없는 항목이 섞여 들어오기 쉽습니다.
+// Check 는 적재 전(import-subjects), 서버 시작 시(subjects.checkOnStartup), CI(univ check-subjects)에서 같은 규칙으로 목록을 검사합니다.
+
+// 일관성 검사 문제 종류
+const (
+	CatalogIssueInvalidValue  = "invalid_value"  // 필수 값이 비었거나 허용하지 않는 값
+	CatalogIssueDuplicateCode = "duplicate_code" // 같은 코드를 가진 항목이 둘 이상
+	CatalogIssueOrphanParent  = "orphan_parent"  // 상위 코드(교과구분종류/교과/수능 영역)가 없음
+	CatalogIssueNameCollision = "name_collision" // 같은 상위 항목 아래에 공백만 다른 같은 이름이 둘 이상
+)
+
+// CatalogIssue 는 과목 목록 일관성 검사에서 찾은 문제 하나입니다.
+type CatalogIssue struct {
+	Kind    string `json:"kind"`           // CatalogIssue* 상수
+	Path    string `json:"path"`           // 목록 파일에서의 위치 (예: "curriculums[37]")
+	Code    string `json:"code,omitempty"` // 문제가 있는 항목의 코드
+	Message string `json:"message"`
+}
+
+func (i CatalogIssue) String() string {
+	if i.Code == "" {
+		return fmt.Sprintf("[%s] %s: %s", i.Kind, i.Path, i.Message)
+	}
+	return fmt.Sprintf("[%s] %s (%s): %s", i.Kind, i.Path, i.Code, i.Message)
+}
+
+// catalogChecker 는 Check 가 문제를 모으는 데 쓰는 상태입니다.
+type catalogChecker struct {
+	issues []CatalogIssue
+}
+
+func (k *catalogChecker) add(kind, path, code, format string, args ...interface{}) {
+	k.issues = append(k.issues, CatalogIssue{Kind: kind, Path: path, Code: code, Message: fmt.Sprintf(format, args...)})
+}
+
+// unique 는 코드 → 처음 나온 위치를 기록하고, 이미 있던 코드면 duplicate_code 로 보고합니다.
+func (k *catalogChecker) unique(seen map[string]string, path, code string) {
+	if code == "" {
+		return
+	}
+	if first, ok := seen[code]; ok {
+		k.add(CatalogIssueDuplicateCode, path, code, "%s 와 코드가 같습니다", first)
+		return
+	}
+	seen[code] = path
+}
+
+// distinctName 은 (상위 코드, 정규화한 이름) → 처음 나온 위치를 기록하고, 이미 있던 이름이면 name_collision 으로 보고합니다.
+func (k *catalogChecker) distinctName(seen map[[2]string]string, path, code, parent, name string) {
+	key := [2]string{parent, normalizeCatalogName(name)}
+	if key[1] == "" {
+		return
+	}
+	if first, ok := seen[key]; ok {
+		k.add(CatalogIssueNameCollision, path, code, "%s 와 같은 상위 항목(%s) 아래에서 이름이 겹칩니다: %q", first, parent, name)
+		return
+	}
+	seen[key] = path
+}
+
+// normalizeCatalogName 은 이름 비교용으로 공백을 모두 지웁니다. ("영미 문학 읽기" 와 "영미문학읽기" 는 같은 이름)
+func normalizeCatalogName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, name)
+}
+
+// Check 는 과목 목록의 일관성을 검사하고 찾은 문제를 모두 반환합니다. 문제가 없으면 빈 목록입니다.
+//   - 코드: 교과구분종류끼리, 교과끼리, 과목(내신+수능)끼리 겹치면 안 됩니다.
+//   - 상위 코드: 교과의 교과구분종류, 내신 과목의 교과, 수능 과목의 영역(suneungAreas)이 있어야 합니다.
+//   - 수능 영역과 선택 규칙: 영역 코드/이름이 겹치지 않고 만점이 있어야 하며, 규칙이 가리키는 영역/과목이 있어야 합니다.
+//   - 이름: 같은 상위 항목 아래에서 공백을 무시하고 같은 이름이 둘 이상이면 안 됩니다. (교과구분종류는 교육과정 버전별)
+//   - 교과 분류: 이름이 대학별로 겹치지 않고, 규칙이 가리키는 교과/교과구분종류/과목/분류가 있어야 합니다. (checkCategories)
+//
+// 비어 있는 과목 코드는 prepare 에서 채우므로, 파일에서 읽은 목록은 prepare 후에 검사합니다.
+func (c *SubjectCatalog) Check() []CatalogIssue {
+	k := &catalogChecker{issues: []CatalogIssue{}}
+
+	classCodes := map[string]string{}
+	classNames := map[[2]string]string{}
+	for i, class := range c.Classifications {
+		path := fmt.Sprintf("classifications[%d]", i)
+		if class.Code == "" || class.Name == "" {
+			k.add(CatalogIssueInvalidValue, path, class.Code, "code 와 name 이 필요합니다")
+		}
+		if problem := class.CurriculumApplicability.validate(); problem != "" {
+			k.add(CatalogIssueInvalidValue, path, class.Code, "%s", problem)
+		}
+		k.unique(classCodes, path, class.Code)
+		k.distinctName(classNames, path, class.Code, class.CurriculumVersion, class.Name)
+	}
+
+	currCodes := map[string]string{}
+	currNames := map[[2]string]string{}
+	for i, curr := range c.Curriculums {
+		path := fmt.Sprintf("curriculums[%d]", i)
+		if curr.Code == "" || curr.Name == "" {
+			k.add(CatalogIssueInvalidValue, path, curr.Code, "code 와 name 이 필요합니다")
+		}
+		if problem := curr.CurriculumApplicability.validate(); problem != "" {
+			k.add(CatalogIssueInvalidValue, path, curr.Code, "%s", problem)
+		}
+		if _, ok := classCodes[curr.ClassificationCode]; !ok {
+			k.add(CatalogIssueOrphanParent, path, curr.Code, "없는 교과구분종류 코드입니다: %q", curr.ClassificationCode)
+		}
+		k.unique(currCodes, path, curr.Code)
+		k.distinctName(currNames, path, curr.Code, curr.ClassificationCode, curr.Name)
+	}
+
+	subjectCodes := map[string]string{}
+	subjectNames := map[[2]string]string{}
+	for i, subj := range c.NaesinSubjects {
+		path := fmt.Sprintf("naesinSubjects[%d]", i)
+		if subj.Name == "" {
+			k.add(CatalogIssueInvalidValue, path, subj.Code, "name 이 필요합니다")
+		}
+		if problem := subj.CurriculumApplicability.validate(); problem != "" {
+			k.add(CatalogIssueInvalidValue, path, subj.Code, "%s", problem)
+		}
+		if _, ok := currCodes[subj.CurriculumCode]; !ok {
+			k.add(CatalogIssueOrphanParent, path, subj.Code, "없는 교과 코드입니다: %q", subj.CurriculumCode)
+		}
+		k.unique(subjectCodes, path, subj.Code)
+		k.distinctName(subjectNames, path, subj.Code, subj.CurriculumCode, subj.Name)
+	}
+	suneungAreaNames := k.checkSuneungAreas(c)
+	for i, subj := range c.SuneungSubjects {
+		path := fmt.Sprintf("suneungSubjects[%d]", i)
+		if subj.Name == "" {
+			k.add(CatalogIssueInvalidValue, path, subj.Code, "name 이 필요합니다")
+		}
+		if problem := subj.CurriculumApplicability.validate(); problem != "" {
+			k.add(CatalogIssueInvalidValue, path, subj.Code, "%s", problem)
+		}
+		if !suneungAreaNames[subj.Area] {
+			k.add(CatalogIssueOrphanParent, path, subj.Code, "없는 수능 영역입니다: %q", subj.Area)
+		}
+		k.unique(subjectCodes, path, subj.Code)
+		// 같은 이름의 과목이 교육과정 버전마다 따로 있을 수 있으므로(예: 2015/2022 "국어") 영역과 버전을 함께 봅니다.
+		k.distinctName(subjectNames, path, subj.Code, "suneung:"+subj.Area+":"+subj.CurriculumVersion, subj.Name)
+	}
+	k.checkSuneungRules(c, suneungAreaNames)
+	k.checkCategories(c)
+	return k.issues
+}
+
+// CheckSubjectCatalog 는 저장소의 현재 과목 목록을 검사합니다. (서버 시작 시 subjects.checkOnStartup)
+func (s *Server) CheckSubjectCatalog() (*SubjectCatalog, []CatalogIssue, error) {
+	catalog, err := s.SubjectCatalog()
+	if err != nil {
+		return nil, nil, err
+	}
+	return catalog, catalog.Check(), nil
+}
@@ -0,0 +1,366 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// --- 교과 분류 (국영수사과, 전 과목 등) ---
+// 대학 계산 스키마는 "국영수사과", "전 과목", "국영수+탐구 상위 N과목" 처럼 교과 묶음 이름으로 반영 과목을 정하지만,
+// 학생 성적(NaesinSubject)에는 교과구분종류/교과/과목 코드만 있습니다. SubjectCategory 는 묶음 이름(label)과
+// 그 묶음에 드는 과목을 정하는 규칙이고, 과목 목록과 함께 적재됩니다. (subject_categories 테이블, 과목 목록 파일의 categories)
+//
+// 같은 이름이라도 대학마다 묶는 방법이 다를 수 있으므로(예: 사회에 한국사 포함 여부) UniversityID 를 준 규칙이
+// 그 대학에서 같은 이름의 기본 규칙(UniversityID "")을 대신합니다. includes 로 다른 분류를 포함하면
+// 그 대학에서 고친 분류가 따라 바뀝니다. (사회를 고치면 국영수사과도 바뀜)
+
+// SubjectCategory 는 교과 분류 하나의 규칙입니다. 과목은 아래 조건 중 하나라도 맞으면 분류에 들고, ExcludeSubjects 에 있으면 빠집니다.
+type SubjectCategory struct {
+	UniversityID    string   `json:"universityId,omitempty"`    // 비우면 모든 대학의 기본값
+	Label           string   `json:"label"`                     // 계산 스키마에서 쓰는 이름 (예: "국영수사과")
+	All             bool     `json:"all,omitempty"`             // 모든 내신 과목 ("전 과목")
+	Includes        []string `json:"includes,omitempty"`        // 포함하는 다른 분류 이름
+	Curriculums     []string `json:"curriculums,omitempty"`     // 교과 이름 (교육과정 버전, 교과구분종류와 관계없이 이름으로 비교)
+	Classifications []string `json:"classifications,omitempty"` // 교과구분종류 이름. Curriculums 와 함께 주면 둘 다 맞아야 합니다.
+	Subjects        []string `json:"subjects,omitempty"`        // 과목 코드
+	ExcludeSubjects []string `json:"excludeSubjects,omitempty"` // 빼는 과목 코드
+}
+
+// hasCriteria 는 과목을 고르는 조건이 하나라도 있는지 확인합니다.
+func (c SubjectCategory) hasCriteria() bool {
+	return c.All || len(c.Includes) > 0 || len(c.Curriculums) > 0 || len(c.Classifications) > 0 || len(c.Subjects) > 0
+}
+
+// subjectCategoryRuleJSON 은 subject_categories.rule_json 에 넣을 규칙입니다. 대학과 이름은 따로 된 열에 넣으므로 빼고 저장합니다.
+func subjectCategoryRuleJSON(c SubjectCategory) (string, error) {
+	c.UniversityID, c.Label = "", ""
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// subjectCategoryIndex 는 대학별 과목 코드 → 분류 이름 목록입니다. (prepare 에서 만듦)
+// 자기 규칙이 없는 대학은 기본값("")을 씁니다.
+type subjectCategoryIndex struct {
+	labels   map[string][]string            // 대학 → 분류 이름 (표시 순서)
+	subjects map[string]map[string][]string // 대학 → 과목 코드 → 분류 이름 (표시 순서)
+}
+
+// categorySubject 는 분류 규칙을 적용할 때 보는 과목 정보입니다.
+type categorySubject struct {
+	code, curriculum, classification string // 코드, 교과 이름, 교과구분종류 이름
+}
+
+// newSubjectCategoryIndex 는 과목 목록의 분류 규칙을 대학별로 적용해 둡니다.
+// 규칙에 문제가 있으면(Check 의 category 문제) 그 부분은 무시합니다.
+func newSubjectCategoryIndex(c *SubjectCatalog) *subjectCategoryIndex {
+	classNames := map[string]string{}
+	for _, class := range c.Classifications {
+		classNames[class.Code] = class.Name
+	}
+	currs := map[string]NaesinCurriculum{}
+	for _, curr := range c.Curriculums {
+		currs[curr.Code] = curr
+	}
+	subjects := make([]categorySubject, 0, len(c.NaesinSubjects))
+	for _, subj := range c.NaesinSubjects {
+		curr := currs[subj.CurriculumCode]
+		subjects = append(subjects, categorySubject{code: subj.Code, curriculum: curr.Name, classification: classNames[curr.ClassificationCode]})
+	}
+
+	// 기본 규칙 위에 대학별 규칙을 덮어씁니다.
+	scopes := map[string][]SubjectCategory{"": nil}
+	for _, cat := range c.Categories {
+		if cat.UniversityID == "" {
+			scopes[""] = append(scopes[""], cat)
+		} else if _, ok := scopes[cat.UniversityID]; !ok {
+			scopes[cat.UniversityID] = nil
+		}
+	}
+	for university := range scopes {
+		if university == "" {
+			continue
+		}
+		rules := slices.Clone(scopes[""])
+		for _, cat := range c.Categories {
+			if cat.UniversityID != university {
+				continue
+			}
+			if i := slices.IndexFunc(rules, func(r SubjectCategory) bool { return r.Label == cat.Label }); i >= 0 {
+				rules[i] = cat
+			} else {
+				rules = append(rules, cat)
+			}
+		}
+		scopes[university] = rules
+	}
+
+	idx := &subjectCategoryIndex{labels: map[string][]string{}, subjects: map[string]map[string][]string{}}
+	for university, rules := range scopes {
+		members := resolveCategoryMembers(rules, subjects)
+		bySubject := map[string][]string{}
+		labels := make([]string, 0, len(rules))
+		for _, rule := range rules {
+			labels = append(labels, rule.Label)
+			for _, subj := range subjects {
+				if members[rule.Label][subj.code] {
+					bySubject[subj.code] = append(bySubject[subj.code], rule.Label)
+				}
+			}
+		}
+		idx.labels[university] = labels
+		idx.subjects[university] = bySubject
+	}
+	return idx
+}
+
+// resolveCategoryMembers 는 한 대학의 규칙 목록으로 분류 이름 → 과목 코드 집합을 구합니다.
+// includes 는 재귀로 풀고, 순환하는 포함은 무시합니다.
+func resolveCategoryMembers(rules []SubjectCategory, subjects []categorySubject) map[string]map[string]bool {
+	byLabel := map[string]SubjectCategory{}
+	for _, rule := range rules {
+		byLabel[rule.Label] = rule
+	}
+	members := map[string]map[string]bool{}
+	visiting := map[string]bool{}
+	var resolve func(label string) map[string]bool
+	resolve = func(label string) map[string]bool {
+		if m, ok := members[label]; ok {
+			return m
+		}
+		rule, ok := byLabel[label]
+		if !ok || visiting[label] {
+			return nil
+		}
+		visiting[label] = true
+		defer delete(visiting, label)
+
+		m := map[string]bool{}
+		for _, subj := range subjects {
+			if rule.matches(subj) {
+				m[subj.code] = true
+			}
+		}
+		for _, included := range rule.Includes {
+			for code := range resolve(included) {
+				m[code] = true
+			}
+		}
+		for _, code := range rule.ExcludeSubjects {
+			delete(m, code)
+		}
+		members[label] = m
+		return m
+	}
+	for _, rule := range rules {
+		resolve(rule.Label)
+	}
+	return members
+}
+
+// matches 는 과목이 규칙의 직접 조건(All, 교과/교과구분종류, 과목 코드)에 맞는지 확인합니다. Includes 는 보지 않습니다.
+func (c SubjectCategory) matches(subj categorySubject) bool {
+	if c.All || slices.Contains(c.Subjects, subj.code) {
+		return true
+	}
+	if len(c.Curriculums) == 0 && len(c.Classifications) == 0 {
+		return false
+	}
+	if len(c.Curriculums) > 0 && !slices.ContainsFunc(c.Curriculums, func(name string) bool {
+		return normalizeCatalogName(name) == normalizeCatalogName(subj.curriculum)
+	}) {
+		return false
+	}
+	if len(c.Classifications) > 0 && !slices.ContainsFunc(c.Classifications, func(name string) bool {
+		return normalizeCatalogName(name) == normalizeCatalogName(subj.classification)
+	}) {
+		return false
+	}
+	return true
+}
+
+// SubjectCategories 는 과목 코드가 universityID 대학에서 드는 분류 이름을 모두 반환합니다. (분류 표시 순서)
+// 대학별 규칙이 없으면 기본 분류를 쓰고, 모르는 과목이면 nil 입니다.
+func (c *SubjectCatalog) SubjectCategories(universityID, subjectCode string) []string {
+	if c.categories == nil {
+		return nil
+	}
+	bySubject, ok := c.categories.subjects[universityID]
+	if !ok {
+		bySubject = c.categories.subjects[""]
+	}
+	return bySubject[subjectCode]
+}
+
+// CategoryLabels 는 universityID 대학에서 쓸 수 있는 분류 이름을 표시 순서로 반환합니다.
+func (c *SubjectCatalog) CategoryLabels(universityID string) []string {
+	if c.categories == nil {
+		return nil
+	}
+	if labels, ok := c.categories.labels[universityID]; ok {
+		return labels
+	}
+	return c.categories.labels[""]
+}
+
+// GpaScores 는 학생의 내신 성적을 universityID 대학의 계산 스키마에 넣을 GpaScore 목록으로 바꿉니다.
+// Categories 에는 그 대학 기준으로 과목이 드는 분류 이름이 모두 들어가므로, FILTER_SUBJECTS_BY_CATEGORY 등이
+// "국영수사과" 같은 대학 분류로 과목을 고를 수 있습니다. Category 는 교과 이름입니다.
+// 학기 키는 "학년-학기"(예: "2-1") 이고, 단위수나 석차등급/성취도가 없는 과목은 넣지 않습니다.
+func (c *SubjectCatalog) GpaScores(universityID string, naesin NaesinGrades, curriculumVersion string) []GpaScore {
+	if curriculumVersion == "" {
+		curriculumVersion = DefaultCurriculumVersion
+	}
+	scale := RankScaleForCurriculum(curriculumVersion)
+	keys := make([]string, 0, len(naesin))
+	for key := range naesin {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var scores []GpaScore
+	for _, key := range keys {
+		yearPart, semesterPart, _ := strings.Cut(key, "-")
+		year, err1 := strconv.Atoi(yearPart)
+		semester, err2 := strconv.Atoi(semesterPart)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		for _, s := range naesin[key] {
+			if s.Credits == nil || (s.Grade == nil && s.AchievementLevel == nil) {
+				continue
+			}
+			score := GpaScore{SubjectName: s.SubjectName, Units: *s.Credits, RankScale: scale, Year: year, Semester: semester}
+			if s.CurriculumAreaName != nil {
+				score.Category = *s.CurriculumAreaName
+			}
+			if s.Grade != nil {
+				score.Rank = *s.Grade
+			}
+			if s.AchievementLevel != nil {
+				score.Achievement = *s.AchievementLevel
+			}
+			if s.SubjectCode != nil {
+				score.SubjectCode = *s.SubjectCode
+				score.Categories = c.SubjectCategories(universityID, *s.SubjectCode)
+			}
+			scores = append(scores, score)
+		}
+	}
+	return scores
+}
+
+// checkCategories 는 분류 규칙을 검사합니다. (Check 에서 호출)
+//   - 이름: 비어 있으면 안 되고, 같은 대학(기본값 포함) 안에서 겹치면 안 됩니다.
+//   - 조건: 하나 이상 있어야 하고, 교과/교과구분종류 이름과 과목 코드, 포함하는 분류가 있어야 합니다.
+//   - 포함: 순환하면 안 됩니다.
+func (k *catalogChecker) checkCategories(c *SubjectCatalog) {
+	currNames := map[string]bool{}
+	for _, curr := range c.Curriculums {
+		currNames[normalizeCatalogName(curr.Name)] = true
+	}
+	classNames := map[string]bool{}
+	for _, class := range c.Classifications {
+		classNames[normalizeCatalogName(class.Name)] = true
+	}
+	subjectCodes := map[string]bool{}
+	for _, subj := range c.NaesinSubjects {
+		subjectCodes[subj.Code] = true
+	}
+
+	seen := map[[2]string]string{}
+	defaults := map[string]bool{}
+	for _, cat := range c.Categories {
+		if cat.UniversityID == "" {
+			defaults[cat.Label] = true
+		}
+	}
+	for i, cat := range c.Categories {
+		path := fmt.Sprintf("categories[%d]", i)
+		if strings.TrimSpace(cat.Label) == "" {
+			k.add(CatalogIssueInvalidValue, path, "", "label 이 필요합니다")
+			continue
+		}
+		key := [2]string{cat.UniversityID, cat.Label}
+		if first, ok := seen[key]; ok {
+			k.add(CatalogIssueDuplicateCode, path, cat.Label, "%s 와 같은 대학(%q)에서 분류 이름이 같습니다", first, cat.UniversityID)
+		} else {
+			seen[key] = path
+		}
+		if !cat.hasCriteria() {
+			k.add(CatalogIssueInvalidValue, path, cat.Label, "all, includes, curriculums, classifications, subjects 중 하나가 필요합니다")
+		}
+		for _, name := range cat.Curriculums {
+			if !currNames[normalizeCatalogName(name)] {
+				k.add(CatalogIssueOrphanParent, path, cat.Label, "없는 교과 이름입니다: %q", name)
+			}
+		}
+		for _, name := range cat.Classifications {
+			if !classNames[normalizeCatalogName(name)] {
+				k.add(CatalogIssueOrphanParent, path, cat.Label, "없는 교과구분종류 이름입니다: %q", name)
+			}
+		}
+		for _, code := range slices.Concat(cat.Subjects, cat.ExcludeSubjects) {
+			if !subjectCodes[code] {
+				k.add(CatalogIssueOrphanParent, path, cat.Label, "없는 내신 과목 코드입니다: %q", code)
+			}
+		}
+		for _, included := range cat.Includes {
+			if !defaults[included] && !slices.ContainsFunc(c.Categories, func(o SubjectCategory) bool {
+				return o.UniversityID == cat.UniversityID && o.Label == included
+			}) {
+				k.add(CatalogIssueOrphanParent, path, cat.Label, "없는 분류를 포함합니다: %q", included)
+			}
+		}
+	}
+
+	// 대학마다 덮어쓴 규칙으로 포함 관계가 달라지므로 대학별로 순환을 찾습니다.
+	universities := []string{""}
+	for _, cat := range c.Categories {
+		if cat.UniversityID != "" && !slices.Contains(universities, cat.UniversityID) {
+			universities = append(universities, cat.UniversityID)
+		}
+	}
+	for _, university := range universities {
+		includes := map[string][]string{}
+		for _, cat := range c.Categories {
+			if cat.UniversityID == "" && includes[cat.Label] == nil {
+				includes[cat.Label] = append([]string{}, cat.Includes...)
+			}
+		}
+		for _, cat := range c.Categories {
+			if university != "" && cat.UniversityID == university {
+				includes[cat.Label] = append([]string{}, cat.Includes...)
+			}
+		}
+		for i, cat := range c.Categories {
+			if cat.UniversityID == university && categoryIncludesCycle(includes, cat.Label) {
+				k.add(CatalogIssueInvalidValue, fmt.Sprintf("categories[%d]", i), cat.Label, "includes 가 자기 자신으로 돌아옵니다")
+			}
+		}
+	}
+}
+
+// categoryIncludesCycle 은 label 에서 includes 를 따라가면 label 로 돌아오는지 확인합니다.
+func categoryIncludesCycle(includes map[string][]string, label string) bool {
+	visited := map[string]bool{}
+	stack := slices.Clone(includes[label])
+	for len(stack) > 0 {
+		next := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if next == label {
+			return true
+		}
+		if visited[next] {
+			continue
+		}
+		visited[next] = true
+		stack = append(stack, includes[next]...)
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// selectedSubjects 는 categories 분류로 과목을 고르는 계산 단계를 실행하고 남은 과목 이름을 반환합니다.
+func selectedSubjects(t *testing.T, scores []GpaScore, categories ...string) []string {
+	t.Helper()
+	params, err := json.Marshal(map[string]any{"categories": categories})
+	require.NoError(t, err)
+	sc := NewScoreCalculator(scores, nil)
+	sc.currentGpaData = scores
+	require.NoError(t, sc.filterSubjectsByCategory(params))
+	var names []string
+	for _, s := range sc.currentGpaData {
+		names = append(names, s.SubjectName)
+	}
+	return names
+}
+
+func TestGpaScoresUniversityCategories(t *testing.T) {
+	store := newMemoryStore(t)
+	_, err := store.DB.Exec(`INSERT INTO subject_categories (university_id, label, display_order, rule_json) VALUES ('U0001', '사회', 4, ?)`,
+		`{"curriculums": ["사회(역사/도덕포함)", "한국사"]}`)
+	require.NoError(t, err)
+	catalog, err := store.Subjects.LoadSubjectCatalog()
+	require.NoError(t, err)
+
+	naesin := NaesinGrades{
+		"1-1": {
+			{SubjectName: "국어", SubjectCode: ptr("NAESIN_국어"), Grade: ptr(1), Credits: ptr(4.0)},
+			{SubjectName: "통합사회", SubjectCode: ptr("NAESIN_통합사회"), Grade: ptr(2), Credits: ptr(3.0)},
+			{SubjectName: "한국사", SubjectCode: ptr("NAESIN_KOREAN_HISTORY_한국사"), Grade: ptr(5), Credits: ptr(3.0)},
+		},
+		"1-2": {
+			{SubjectName: "단위수 없음", SubjectCode: ptr("NAESIN_국어"), Grade: ptr(1)},
+		},
+	}
+
+	defaults := catalog.GpaScores("U0002", naesin, "2015")
+	require.Len(t, defaults, 3, "단위수가 없는 과목은 넣지 않습니다")
+	assert.Equal(t, 1, defaults[0].Year)
+	assert.Equal(t, 1, defaults[0].Semester)
+	assert.Equal(t, RankScale9, defaults[0].RankScale)
+	assert.Equal(t, []string{"통합사회"}, selectedSubjects(t, defaults, "사회"))
+	assert.Equal(t, []string{"국어", "통합사회"}, selectedSubjects(t, defaults, "국영수사"))
+
+	// U0001 은 사회에 한국사를 넣으므로, 사회를 포함하는 국영수사에도 한국사가 들어갑니다.
+	overridden := catalog.GpaScores("U0001", naesin, "2015")
+	assert.Contains(t, overridden[2].Categories, "사회")
+	assert.Equal(t, []string{"통합사회", "한국사"}, selectedSubjects(t, overridden, "사회"))
+	assert.Equal(t, []string{"국어", "통합사회", "한국사"}, selectedSubjects(t, overridden, "국영수사"))
+	assert.Equal(t, []string{"한국사"}, selectedSubjects(t, overridden, "한국사"), "다른 분류는 기본값 그대로입니다")
+
+	// 2022 개정 교육과정 성적은 5등급제입니다.
+	assert.Equal(t, RankScale5, catalog.GpaScores("U0001", naesin, "2022")[0].RankScale)
+}
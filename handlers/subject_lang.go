@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+)
+
+// --- 과목 목록 언어 ---
+// 국제학교 진학 상담처럼 영어 이름이 필요한 곳이 있어, 과목 목록의 항목은 한국어 이름(Name)과 영어 이름(NameEn)을 가집니다.
+// GET /api/subjects 는 lang 쿼리 파라미터(없으면 Accept-Language 헤더)로 subjectName 의 언어를 고르며, 코드는 언어와 관계없이 같습니다.
+// 영어 이름이 비어 있는 항목(전문교과 과목 등)은 한국어 이름을 그대로 씁니다.
+
+// 과목 목록 언어
+const (
+	LangKorean  = "ko"
+	LangEnglish = "en"
+)
+
+// subjectLangs 는 lang 파라미터로 쓸 수 있는 값입니다. 순서는 subjectLangMatcher 의 태그와 같습니다.
+var subjectLangs = []string{LangKorean, LangEnglish}
+
+// subjectLangMatcher 는 Accept-Language 헤더에서 지원하는 언어를 고릅니다.
+var subjectLangMatcher = language.NewMatcher([]language.Tag{language.Korean, language.English})
+
+// localizedName 은 lang 에 맞는 이름을 반환합니다. 영어 이름이 없으면 한국어 이름입니다.
+func localizedName(lang, name, nameEn string) string {
+	if lang == LangEnglish && nameEn != "" {
+		return nameEn
+	}
+	return name
+}
+
+// parseSubjectLang 은 lang 쿼리 파라미터나 Accept-Language 헤더로 응답 언어를 정하고 Content-Language 헤더를 붙입니다.
+// lang 값이 잘못되면 400 으로 응답하고 false 를 반환합니다. Accept-Language 가 지원하지 않는 언어뿐이면 한국어입니다.
+func parseSubjectLang(c *gin.Context) (string, bool) {
+	lang := LangKorean
+	if v := strings.ToLower(c.Query("lang")); v != "" {
+		if !slices.Contains(subjectLangs, v) {
+			abortWithError(c, http.StatusBadRequest, ErrCodeInvalidParameter, "유효하지 않은 lang 값입니다: "+c.Query("lang"),
+				gin.H{"parameter": "lang", "allowed": subjectLangs})
+			return "", false
+		}
+		lang = v
+	} else if tags, _, err := language.ParseAcceptLanguage(c.GetHeader("Accept-Language")); err == nil && len(tags) > 0 {
+		// 맞는 언어가 없을 때도 Match 가 영어를 고를 수 있으므로(예: fr) 확신도가 없으면 한국어로 둡니다.
+		if _, i, confidence := subjectLangMatcher.Match(tags...); confidence != language.No {
+			lang = subjectLangs[i]
+		}
+	}
+	c.Header("Content-Language", lang)
+	// CORS 미들웨어가 붙인 Vary: Origin 을 지우지 않도록 Set 대신 Add 합니다.
+	c.Writer.Header().Add("Vary", "Accept-Language")
+	return lang, true
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubjectLang(t *testing.T) {
+	server, err := NewFixtureServer(nil)
+	require.NoError(t, err)
+	router := server.Router()
+
+	tests := []struct {
+		name           string
+		query          string
+		acceptLanguage string
+		wantStatus     int
+		wantLang       string
+	}{
+		{"기본값", "", "", http.StatusOK, LangKorean},
+		{"lang 파라미터", "&lang=en", "", http.StatusOK, LangEnglish},
+		{"lang 대소문자", "&lang=EN", "", http.StatusOK, LangEnglish},
+		{"lang 이 헤더보다 우선", "&lang=ko", "en-US", http.StatusOK, LangKorean},
+		{"Accept-Language", "", "en-US,en;q=0.9", http.StatusOK, LangEnglish},
+		{"지원하지 않는 언어", "", "fr", http.StatusOK, LangKorean},
+		{"잘못된 lang", "&lang=fr", "", http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/subjects?type=naesin_curriculum_classifications"+tt.query, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+			if tt.wantLang != "" {
+				assert.Equal(t, tt.wantLang, w.Header().Get("Content-Language"))
+			}
+		})
+	}
+}
+
+// Vary: Accept-Language 를 붙여도 CORS 미들웨어의 Vary: Origin 이 남아 있어야 합니다.
+func TestSubjectLangKeepsCORSVary(t *testing.T) {
+	server, err := NewFixtureServer(nil)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/subjects?type=naesin_curriculum_classifications", nil)
+	req.Header.Set("Origin", "https://example.com")
+	w := httptest.NewRecorder()
+	server.Router().ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	vary := w.Header().Values("Vary")
+	assert.Contains(t, vary, "Origin")
+	assert.Contains(t, vary, "Accept-Language")
+}
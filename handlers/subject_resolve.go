@@ -0,0 +1,341 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- 과목명 찾기 (GET /api/subjects/resolve) ---
+// 학생부 파일이나 직접 입력한 교과구분종류/교과/과목 이름을 과목 목록의 코드로 바꿉니다.
+// 이름은 띄어쓰기, 로마 숫자 표기("수학 I" / "수학Ⅰ" / "수학1"), 가운뎃점, 전각 문자, 흔한 줄임말("확통", "생윤")을
+// 정규화한 뒤 비교하고, 완전히 같지 않으면 편집 거리로 비슷한 정도를 계산합니다.
+
+const (
+	// resolveMinConfidence 보다 신뢰도가 낮은 후보는 match 로 고르지 않습니다. (alternatives 에는 나옴)
+	resolveMinConfidence = 0.6
+	// resolveMinSimilarity 보다 과목명이 덜 비슷한 과목은 후보에서 뺍니다.
+	resolveMinSimilarity = 0.5
+	resolveMaxQueries    = 100 // 한 요청의 최대 q 개수
+	resolveAlternatives  = 3   // match 외에 돌려주는 후보 수
+)
+
+// SubjectResolveQuery 는 찾을 이름 한 건입니다. 과목명만 필수이고, 교과구분종류/교과 이름은 같은 이름의 과목을 구분하는 데 씁니다.
+type SubjectResolveQuery struct {
+	Classification string `json:"classification,omitempty"`
+	Curriculum     string `json:"curriculum,omitempty"`
+	Subject        string `json:"subject"`
+}
+
+// SubjectMatch 는 과목 목록에서 찾은 후보입니다. Confidence 는 0~1 이며 1 이면 정규화한 이름이 모두 같습니다.
+type SubjectMatch struct {
+	ClassificationCode string  `json:"classificationCode"`
+	ClassificationName string  `json:"classificationName"`
+	CurriculumCode     string  `json:"curriculumCode"`
+	CurriculumName     string  `json:"curriculumName"`
+	SubjectCode        string  `json:"subjectCode"`
+	SubjectName        string  `json:"subjectName"`
+	CurriculumVersion  string  `json:"curriculumVersion"`
+	Confidence         float64 `json:"confidence"`
+}
+
+// SubjectResolveResult 는 이름 한 건의 결과입니다.
+// Match 는 신뢰도가 가장 높은 후보이며(resolveMinConfidence 미만이면 null), 같은 신뢰도의 다른 과목이 있으면 Ambiguous 입니다.
+type SubjectResolveResult struct {
+	Query        SubjectResolveQuery `json:"query"`
+	Match        *SubjectMatch       `json:"match"`
+	Ambiguous    bool                `json:"ambiguous"`
+	Alternatives []SubjectMatch      `json:"alternatives"`
+}
+
+// subjectResolver 는 과목 목록의 이름을 미리 정규화해 둔 색인입니다. (SubjectCatalog.prepare 에서 만듦)
+type subjectResolver struct {
+	entries []resolveEntry
+}
+
+type resolveEntry struct {
+	match          SubjectMatch // Confidence 제외
+	applicability  CurriculumApplicability
+	subjectKey     string
+	curriculumKeys []string // 교과 이름, 괄호를 뺀 교과 이름 (예: "사회(역사/도덕포함)" → "사회")
+	classKey       string
+}
+
+func newSubjectResolver(c *SubjectCatalog) *subjectResolver {
+	classNames := map[string]string{}
+	for _, class := range c.Classifications {
+		classNames[class.Code] = class.Name
+	}
+	type currInfo struct{ name, classCode string }
+	currs := map[string]currInfo{}
+	for _, curr := range c.Curriculums {
+		currs[curr.Code] = currInfo{curr.Name, curr.ClassificationCode}
+	}
+
+	r := &subjectResolver{entries: make([]resolveEntry, 0, len(c.NaesinSubjects))}
+	for _, subj := range c.NaesinSubjects {
+		curr := currs[subj.CurriculumCode]
+		className := classNames[curr.classCode]
+		currKeys := []string{normalizeSubjectName(curr.name)}
+		if stripped := stripParenthesized(curr.name); stripped != curr.name {
+			currKeys = append(currKeys, normalizeSubjectName(stripped))
+		}
+		r.entries = append(r.entries, resolveEntry{
+			match: SubjectMatch{
+				ClassificationCode: curr.classCode,
+				ClassificationName: className,
+				CurriculumCode:     subj.CurriculumCode,
+				CurriculumName:     curr.name,
+				SubjectCode:        subj.Code,
+				SubjectName:        subj.Name,
+				CurriculumVersion:  subj.CurriculumVersion,
+			},
+			applicability:  subj.CurriculumApplicability,
+			subjectKey:     normalizeSubjectName(subj.Name),
+			curriculumKeys: currKeys,
+			classKey:       normalizeSubjectName(className),
+		})
+	}
+	return r
+}
+
+// resolve 는 accept 가 허용하는 과목 중에서 q 에 맞는 후보를 신뢰도 순으로 찾습니다.
+func (r *subjectResolver) resolve(q SubjectResolveQuery, accept func(CurriculumApplicability) bool) SubjectResolveResult {
+	result := SubjectResolveResult{Query: q, Alternatives: []SubjectMatch{}}
+
+	subjectKey := normalizeSubjectName(q.Subject)
+	expandedKey, abbreviated := subjectAbbreviations[subjectKey]
+	currKey := normalizeSubjectName(q.Curriculum)
+	classKey := normalizeSubjectName(q.Classification)
+
+	var candidates []SubjectMatch
+	for _, e := range r.entries {
+		if !accept(e.applicability) {
+			continue
+		}
+		score := nameSimilarity(subjectKey, e.subjectKey)
+		if abbreviated && expandedKey == e.subjectKey && score < 1 {
+			score = 0.95 // 줄임말은 정확히 같은 이름보다 조금 낮게
+		}
+		if score < resolveMinSimilarity {
+			continue
+		}
+
+		// 교과구분종류/교과 이름을 주었으면 그 이름이 맞을수록 높게, 틀리면 최대 40% 낮춥니다.
+		var hints []float64
+		if currKey != "" {
+			best := 0.0
+			for _, k := range e.curriculumKeys {
+				best = math.Max(best, nameSimilarity(currKey, k))
+			}
+			hints = append(hints, best)
+		}
+		if classKey != "" {
+			hints = append(hints, nameSimilarity(classKey, e.classKey))
+		}
+		if len(hints) > 0 {
+			sum := 0.0
+			for _, h := range hints {
+				sum += h
+			}
+			score *= 0.6 + 0.4*sum/float64(len(hints))
+		}
+
+		m := e.match
+		m.Confidence = math.Round(score*1000) / 1000
+		candidates = append(candidates, m)
+	}
+
+	// 신뢰도가 같으면 과목 목록 순서를 유지합니다.
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Confidence > candidates[j].Confidence })
+	if len(candidates) > 0 && candidates[0].Confidence >= resolveMinConfidence {
+		best := candidates[0]
+		result.Match = &best
+		candidates = candidates[1:]
+		result.Ambiguous = len(candidates) > 0 && candidates[0].Confidence == best.Confidence
+	}
+	if len(candidates) > resolveAlternatives {
+		candidates = candidates[:resolveAlternatives]
+	}
+	result.Alternatives = append(result.Alternatives, candidates...)
+	return result
+}
+
+// romanNumeralPattern 은 라틴 문자로 적은 로마 숫자(I ~ IV)입니다. 영문 단어 안의 I 는 바꾸지 않습니다.
+var romanNumeralPattern = regexp.MustCompile(`(^|[^A-Za-z])(IV|III|II|I)($|[^A-Za-z])`)
+
+var romanNumeralDigits = map[string]string{"I": "1", "II": "2", "III": "3", "IV": "4"}
+
+// normalizeSubjectName 은 이름 비교용 키를 만듭니다.
+// 전각 문자를 반각으로, 로마 숫자(Ⅰ, I)를 아라비아 숫자로 바꾸고, 공백/가운뎃점/마침표를 지운 뒤 영문을 소문자로 바꿉니다.
+// 예: "수학 I", "수학Ⅰ", "수학1" → "수학1", "사회·문화", "사회 문화" → "사회문화"
+func normalizeSubjectName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 0xFF01 && r <= 0xFF5E: // 전각 ASCII
+			b.WriteRune(r - 0xFEE0)
+		case r >= 'Ⅰ' && r <= 'Ⅻ':
+			b.WriteString(" " + strconv.Itoa(int(r-'Ⅰ')+1) + " ")
+		case r >= 'ⅰ' && r <= 'ⅻ':
+			b.WriteString(" " + strconv.Itoa(int(r-'ⅰ')+1) + " ")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	s := b.String()
+	// 붙어 있는 로마 숫자가 한 번에 하나씩만 바뀌므로(경계 문자를 공유) 두 번 적용합니다.
+	for i := 0; i < 2; i++ {
+		s = romanNumeralPattern.ReplaceAllStringFunc(s, func(m string) string {
+			sub := romanNumeralPattern.FindStringSubmatch(m)
+			return sub[1] + romanNumeralDigits[sub[2]] + sub[3]
+		})
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r), r == '·', r == 'ㆍ', r == '・', r == '•', r == '‧', r == '∙', r == '.':
+			return -1
+		case r < unicode.MaxASCII:
+			return unicode.ToLower(r)
+		}
+		return r
+	}, s)
+}
+
+// stripParenthesized 는 괄호와 그 안의 내용을 지웁니다. (예: "사회(역사/도덕포함)" → "사회")
+func stripParenthesized(name string) string {
+	if i := strings.IndexAny(name, "(（"); i > 0 {
+		return strings.TrimSpace(name[:i])
+	}
+	return name
+}
+
+// nameSimilarity 는 정규화한 두 이름의 비슷한 정도(0~1)입니다. 1 - 편집 거리 / 긴 쪽 길이.
+func nameSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// subjectAbbreviations 는 정규화한 줄임말 → 정규화한 과목명입니다. 학생부/수험생이 흔히 쓰는 줄임말만 넣습니다.
+var subjectAbbreviations = func() map[string]string {
+	raw := map[string]string{
+		"확통": "확률과 통계", "미적": "미적분", "기벡": "기하와 벡터",
+		"화작": "화법과 작문", "언매": "언어와 매체", "독문": "독서와 문법",
+		"생윤": "생활과 윤리", "윤사": "윤리와 사상", "사문": "사회·문화", "정법": "정치와 법",
+		"한지": "한국지리", "세지": "세계지리", "동사": "동아시아사", "세사": "세계사",
+		"통사": "통합사회", "통과": "통합과학", "과탐실": "과학탐구실험", "기가": "기술·가정",
+		"물1": "물리학Ⅰ", "물2": "물리학Ⅱ", "화1": "화학Ⅰ", "화2": "화학Ⅱ",
+		"생1": "생명과학Ⅰ", "생2": "생명과학Ⅱ", "지1": "지구과학Ⅰ", "지2": "지구과학Ⅱ",
+		"수1": "수학Ⅰ", "수2": "수학Ⅱ", "영1": "영어Ⅰ", "영2": "영어Ⅱ",
+		"AI 수학": "인공지능 수학", "AI 기초": "인공지능 기초",
+	}
+	m := make(map[string]string, len(raw))
+	for abbr, full := range raw {
+		m[normalizeSubjectName(abbr)] = normalizeSubjectName(full)
+	}
+	return m
+}()
+
+// ResolveSubjects 핸들러는 교과구분종류/교과/과목 이름을 과목 목록의 코드로 찾습니다.
+// 한 건은 subject(+ curriculum, classification) 파라미터로, 여러 건은 q 를 반복해서 보냅니다.
+// q 는 "교과구분종류|교과|과목", "교과|과목", "과목" 중 하나의 형식입니다. (오른쪽이 과목)
+// curriculumVersion, admissionYear 를 주면 해당 교육과정 과목 중에서만 찾습니다.
+// GET /api/subjects/resolve?q=일반선택|수학|수학 I&q=확통
+func (s *Server) ResolveSubjects(c *gin.Context) {
+	var queries []SubjectResolveQuery
+	if qs := c.QueryArray("q"); len(qs) > 0 {
+		if len(qs) > resolveMaxQueries {
+			abortWithError(c, http.StatusBadRequest, ErrCodeInvalidParameter, "q 는 한 번에 최대 100개까지 보낼 수 있습니다.",
+				gin.H{"parameter": "q", "max": resolveMaxQueries})
+			return
+		}
+		for i, q := range qs {
+			query := parseResolveQuery(q)
+			if query.Subject == "" {
+				abortWithError(c, http.StatusBadRequest, ErrCodeInvalidParameter, "q 의 과목명이 비어 있습니다: "+q,
+					gin.H{"parameter": "q", "index": i})
+				return
+			}
+			queries = append(queries, query)
+		}
+	} else {
+		query := SubjectResolveQuery{
+			Classification: strings.TrimSpace(c.Query("classification")),
+			Curriculum:     strings.TrimSpace(c.Query("curriculum")),
+			Subject:        strings.TrimSpace(c.Query("subject")),
+		}
+		if query.Subject == "" {
+			abortWithError(c, http.StatusBadRequest, ErrCodeInvalidParameter, "subject 또는 q 파라미터가 필요합니다.",
+				gin.H{"parameter": "subject"})
+			return
+		}
+		queries = append(queries, query)
+	}
+
+	filter, ok := parseCurriculumFilter(c)
+	if !ok {
+		return
+	}
+	catalog, err := s.SubjectCatalog()
+	if err != nil {
+		abortWithInternalError(c, "과목 목록 조회 중 에러가 발생했습니다.", err)
+		return
+	}
+
+	results := make([]SubjectResolveResult, 0, len(queries))
+	for _, q := range queries {
+		results = append(results, catalog.resolver.resolve(q, filter.matches))
+	}
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// parseResolveQuery 는 "교과구분종류|교과|과목" 형식의 q 값을 나눕니다. 앞쪽은 생략할 수 있습니다.
+func parseResolveQuery(q string) SubjectResolveQuery {
+	parts := strings.Split(q, "|")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	var query SubjectResolveQuery
+	n := len(parts)
+	query.Subject = parts[n-1]
+	if n >= 2 {
+		query.Curriculum = parts[n-2]
+	}
+	if n >= 3 {
+		query.Classification = parts[n-3]
+	}
+	return query
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getSubjects(t *testing.T, router http.Handler, query string) []ApiSubjectInfo {
+	t.Helper()
+	w := serve(t, router, http.MethodGet, "/api/subjects?"+query, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var results []ApiSubjectInfo
+	decodeJSON(t, w, &results)
+	return results
+}
+
+func TestSubjectNaesinHierarchy(t *testing.T) {
+	router := newFixtureRouter(t, nil)
+
+	classifications := getSubjects(t, router, "type=naesin_curriculum_classifications&curriculumVersion=2015")
+	require.NotEmpty(t, classifications)
+	for _, class := range classifications {
+		assert.Nil(t, class.ParentCode)
+		assert.Equal(t, "2015", class.CurriculumVersion)
+	}
+
+	class := classifications[0]
+	curriculums := getSubjects(t, router, "type=naesin_curriculums_for_classification&classificationCode="+class.SubjectCode)
+	require.NotEmpty(t, curriculums)
+	for _, curr := range curriculums {
+		require.NotNil(t, curr.ParentCode)
+		assert.Equal(t, class.SubjectCode, *curr.ParentCode)
+	}
+
+	subjects := getSubjects(t, router, "type=naesin_subjects_for_curriculum&curriculumCode="+curriculums[0].SubjectCode)
+	require.NotEmpty(t, subjects)
+	for _, subj := range subjects {
+		require.NotNil(t, subj.ParentCode)
+		assert.Equal(t, curriculums[0].SubjectCode, *subj.ParentCode)
+	}
+
+	// 조건에 맞는 항목이 없어도 200 과 빈 배열입니다.
+	assert.Empty(t, getSubjects(t, router, "type=naesin_subjects_for_curriculum&curriculumCode=NO_SUCH_CODE"))
+}
+
+func TestSubjectCurriculumFilter(t *testing.T) {
+	router := newFixtureRouter(t, nil)
+
+	for _, version := range []string{"2015", "2022"} {
+		subjects := getSubjects(t, router, "type=naesin_subjects_all&curriculumVersion="+version)
+		require.NotEmpty(t, subjects, version)
+		for _, subj := range subjects {
+			assert.Equal(t, version, subj.CurriculumVersion, subj.SubjectCode)
+		}
+	}
+
+	// 2028학년도 수능부터는 2022 개정 교육과정의 통합형 과목만 있습니다.
+	for _, subj := range getSubjects(t, router, "type=suneung_국어&admissionYear=2028") {
+		assert.Equal(t, "2022", subj.CurriculumVersion, subj.SubjectCode)
+	}
+}
+
+func TestSubjectSuneung(t *testing.T) {
+	router := newFixtureRouter(t, nil)
+
+	for _, subjectType := range []string{"suneung_국어", "suneung_수학", "suneung_영어", "suneung_한국사", "suneung_탐구", "suneung_제2외국어"} {
+		assert.NotEmpty(t, getSubjects(t, router, "type="+subjectType+"&admissionYear=2026"), subjectType)
+	}
+
+	w := serve(t, router, http.MethodGet, "/api/subjects?type=suneung_catalog&admissionYear=2026", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var catalog SuneungCatalogResponse
+	decodeJSON(t, w, &catalog)
+	require.NotEmpty(t, catalog.Areas)
+	for _, area := range catalog.Areas {
+		assert.NotEmpty(t, area.Label, area.Code)
+		assert.NotEmpty(t, area.Subjects, area.Code)
+	}
+	assert.NotEmpty(t, catalog.Rules)
+}
+
+func TestSubjectNaesinTreeETag(t *testing.T) {
+	router := newFixtureRouter(t, nil)
+	path := "/api/subjects?type=naesin_tree&curriculumVersion=2015"
+
+	w := serve(t, router, http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	var tree []NaesinTreeNode
+	decodeJSON(t, w, &tree)
+	require.NotEmpty(t, tree)
+	assert.NotEmpty(t, tree[0].Children)
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	// 조회 조건이 다르면 ETag 도 다릅니다.
+	w = serve(t, router, http.MethodGet, path+"&lang=en", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, etag, w.Header().Get("ETag"))
+}
+
+func TestSubjectErrors(t *testing.T) {
+	router := newFixtureRouter(t, nil)
+
+	tests := []struct {
+		name          string
+		query         string
+		wantParameter string
+	}{
+		{"type 없음", "", "type"},
+		{"잘못된 type", "type=naesin_unknown", "type"},
+		{"classificationCode 없음", "type=naesin_curriculums_for_classification", "classificationCode"},
+		{"curriculumCode 없음", "type=naesin_subjects_for_curriculum", "curriculumCode"},
+		{"잘못된 교육과정 버전", "type=naesin_subjects_all&curriculumVersion=2030", "curriculumVersion"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(t, router, http.MethodGet, "/api/subjects?"+tt.query, nil)
+			apiErr := requireAPIError(t, w, http.StatusBadRequest, ErrCodeInvalidParameter)
+			details, ok := apiErr.Details.(map[string]any)
+			require.True(t, ok, "details 는 객체여야 합니다: %v", apiErr.Details)
+			assert.Equal(t, tt.wantParameter, details["parameter"])
+		})
+	}
+}
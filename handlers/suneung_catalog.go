@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// --- 수능 영역과 선택 규칙 ---
+// 수능 과목(subjects_master 의 suneung 행)은 영역(suneung_areas)에 속하고, 원점수 만점과 절대평가 여부는 영역마다 정해집니다.
+// 응시자가 함께 고를 수 없는 조합(탐구 2과목, 물리학Ⅰ+물리학Ⅱ 등)은 선택 규칙(suneung_selection_rules)으로 과목 목록과 함께 적재하며,
+// GET /api/subjects?type=suneung_catalog 로 내려 주고 FilterUniversities 의 수능 성적 검증(validateSuneungGrades)에 씁니다.
+
+// SuneungArea 는 수능 영역 하나입니다. 과목의 Area 는 영역의 Name 입니다.
+type SuneungArea struct {
+	Code            string `json:"code"`                      // 예: "SUNEUNG_AREA_ENGLISH"
+	Name            string `json:"name"`                      // 예: "영어"
+	NameEn          string `json:"nameEn,omitempty"`          // 영어 이름 (예: "English")
+	MaxRawScore     int    `json:"maxRawScore"`               // 원점수 만점 (국어/수학/영어 100, 나머지 50)
+	AbsoluteGrading bool   `json:"absoluteGrading,omitempty"` // 원점수로 등급을 정하는 절대평가 영역 (영어, 한국사, 제2외국어/한문)
+	PayloadKey      string `json:"payloadKey"`                // 수능 성적 요청의 subjects 키. "explorer" 는 explorer1, explorer2 … 로 씁니다.
+}
+
+// 수능 선택 규칙 종류
+const (
+	SuneungRuleMaxCount  = "max_count" // Areas 의 과목을 Max 개보다 많이 고를 수 없음 (예: 탐구 2과목)
+	SuneungRuleExclusive = "exclusive" // Subjects 중 둘 이상, 또는 Areas 중 두 영역 이상의 과목을 함께 고를 수 없음
+)
+
+// SuneungSelectionRule 은 수능 과목 선택 규칙 하나입니다. 교육과정 버전/대입 학년도 범위가 맞는 성적에만 적용합니다.
+type SuneungSelectionRule struct {
+	Code     string   `json:"code"`               // 예: "SUNEUNG_RULE_PHYSICS_1_2"
+	Name     string   `json:"name"`               // 사용자에게 보여 줄 규칙 (예: "물리학Ⅰ+물리학Ⅱ 동시 선택 불가")
+	NameEn   string   `json:"nameEn,omitempty"`   // 영어 이름
+	Kind     string   `json:"kind"`               // SuneungRule* 상수
+	Areas    []string `json:"areas,omitempty"`    // 영역 이름
+	Subjects []string `json:"subjects,omitempty"` // 수능 과목 코드
+	Max      int      `json:"max,omitempty"`      // max_count 의 최대 과목 수
+	CurriculumApplicability
+}
+
+// suneungPayloadKeys 는 영역의 PayloadKey 로 쓸 수 있는 값입니다.
+var suneungPayloadKeys = []string{"korean", "math", "english", "history", "explorer", "foreignLanguage"}
+
+// suneungExplorerKeyPattern 은 탐구 과목 키(explorer1, explorer2 …)입니다.
+var suneungExplorerKeyPattern = regexp.MustCompile(`^explorer[1-9]$`)
+
+// defaultSuneungAreas 는 영역 목록이 없는 과목 목록 파일(영역 도입 전)에 채우는 기본 영역입니다. (마이그레이션 0010 과 같음)
+var defaultSuneungAreas = []SuneungArea{
+	{Code: "SUNEUNG_AREA_KOREAN", Name: SuneungAreaKorean, NameEn: "Korean", MaxRawScore: 100, PayloadKey: "korean"},
+	{Code: "SUNEUNG_AREA_MATH", Name: SuneungAreaMath, NameEn: "Mathematics", MaxRawScore: 100, PayloadKey: "math"},
+	{Code: "SUNEUNG_AREA_ENGLISH", Name: SuneungAreaEnglish, NameEn: "English", MaxRawScore: 100, AbsoluteGrading: true, PayloadKey: "english"},
+	{Code: "SUNEUNG_AREA_KOREAN_HISTORY", Name: SuneungAreaKoreanHistory, NameEn: "Korean History", MaxRawScore: 50, AbsoluteGrading: true, PayloadKey: "history"},
+	{Code: "SUNEUNG_AREA_SOCIAL", Name: SuneungAreaSocial, NameEn: "Social Studies Inquiry", MaxRawScore: 50, PayloadKey: "explorer"},
+	{Code: "SUNEUNG_AREA_SCIENCE", Name: SuneungAreaScience, NameEn: "Science Inquiry", MaxRawScore: 50, PayloadKey: "explorer"},
+	{Code: "SUNEUNG_AREA_VOCATIONAL", Name: SuneungAreaVocational, NameEn: "Vocational Inquiry", MaxRawScore: 50, PayloadKey: "explorer"},
+	{Code: "SUNEUNG_AREA_SECOND_FOREIGN_LANGUAGE", Name: SuneungAreaSecondForeignLanguage, NameEn: "Second Foreign Language/Classical Chinese", MaxRawScore: 50, AbsoluteGrading: true, PayloadKey: "foreignLanguage"},
+}
+
+// suneungSelectionRuleJSON 은 suneung_selection_rules.rule_json 에 넣을 규칙입니다. 코드, 이름, 적용 범위는 따로 된 열에 넣습니다.
+func suneungSelectionRuleJSON(r SuneungSelectionRule) (string, error) {
+	b, err := json.Marshal(struct {
+		Kind     string   `json:"kind"`
+		Areas    []string `json:"areas,omitempty"`
+		Subjects []string `json:"subjects,omitempty"`
+		Max      int      `json:"max,omitempty"`
+	}{r.Kind, r.Areas, r.Subjects, r.Max})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// violatedBy 는 selected(한 응시자가 고른 과목)가 규칙을 어기는지 확인합니다.
+func (r SuneungSelectionRule) violatedBy(selected []SuneungSubject) bool {
+	switch r.Kind {
+	case SuneungRuleMaxCount:
+		count := 0
+		for _, subj := range selected {
+			if slices.Contains(r.Areas, subj.Area) {
+				count++
+			}
+		}
+		return count > r.Max
+	case SuneungRuleExclusive:
+		subjects, areas := 0, map[string]bool{}
+		for _, subj := range selected {
+			if slices.Contains(r.Subjects, subj.Code) {
+				subjects++
+			}
+			if slices.Contains(r.Areas, subj.Area) {
+				areas[subj.Area] = true
+			}
+		}
+		return subjects > 1 || len(areas) > 1
+	}
+	return false
+}
+
+// suneungAreasForKey 는 수능 성적 요청의 subjects 키에 해당하는 영역을 반환합니다. 모르는 키면 빈 목록입니다.
+func (c *SubjectCatalog) suneungAreasForKey(key string) []SuneungArea {
+	if suneungExplorerKeyPattern.MatchString(key) {
+		key = "explorer"
+	} else if key == "explorer" {
+		return nil // 탐구는 번호를 붙여야 합니다.
+	}
+	var areas []SuneungArea
+	for _, area := range c.SuneungAreas {
+		if area.PayloadKey == key {
+			areas = append(areas, area)
+		}
+	}
+	return areas
+}
+
+// suneungAllowedKeys 는 오류 메시지에 보여 줄 subjects 키 목록입니다.
+func (c *SubjectCatalog) suneungAllowedKeys() []string {
+	var keys []string
+	for _, area := range c.SuneungAreas {
+		key := area.PayloadKey
+		if key == "explorer" {
+			key = "explorer1, explorer2 …"
+		}
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// validateSuneungSubjects 는 수능 성적의 과목별 원점수와 과목명을 영역에 맞게 확인하고, 고른 과목 조합이 선택 규칙을 어기지 않는지 확인합니다.
+// admissionYear 가 0 이면(시행 연도를 모름) 모든 학년도의 과목과 규칙을 봅니다.
+func validateSuneungSubjects(errs *fieldErrors, path string, subjects map[string]SuneungSubjectScore, catalog *SubjectCatalog, admissionYear int) {
+	keys := make([]string, 0, len(subjects))
+	for key := range subjects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var selected []SuneungSubject
+	chosen := map[string]string{} // 과목 코드 → 처음 고른 키의 경로
+	for _, key := range keys {
+		score := subjects[key]
+		subjectPath := path + "." + key
+		areas := catalog.suneungAreasForKey(key)
+		if len(areas) == 0 {
+			errs.add(subjectPath, "알 수 없는 수능 과목 키입니다: %q (허용: %s)", key, strings.Join(catalog.suneungAllowedKeys(), ", "))
+			continue
+		}
+		name := score.subjectName()
+		if name == "" && score.RawScore == nil {
+			continue // 입력하지 않은 과목
+		}
+
+		var candidates []SuneungSubject
+		for _, subj := range catalog.SuneungSubjects {
+			if subj.appliesTo("", admissionYear) && slices.ContainsFunc(areas, func(a SuneungArea) bool { return a.Name == subj.Area }) {
+				candidates = append(candidates, subj)
+			}
+		}
+		area := areas[0]
+		subj, found := SuneungSubject{}, false
+		switch {
+		case name != "":
+			i := slices.IndexFunc(candidates, func(s SuneungSubject) bool { return normalizeCatalogName(s.Name) == normalizeCatalogName(name) })
+			if i < 0 {
+				errs.add(subjectPath, "%s 영역에 없는 과목입니다: %q", suneungAreaNames(areas), name)
+			} else {
+				subj, found = candidates[i], true
+			}
+		case len(candidates) == 1:
+			subj, found = candidates[0], true
+		case len(candidates) == 0:
+			errs.add(subjectPath, "%s 영역에서 고를 수 있는 과목이 없습니다 (대입 학년도 %d)", suneungAreaNames(areas), admissionYear)
+		default:
+			errs.add(subjectPath, "%s 영역은 과목명(selectedOption 또는 subjectName)이 필요합니다", suneungAreaNames(areas))
+		}
+		if found {
+			area = areas[slices.IndexFunc(areas, func(a SuneungArea) bool { return a.Name == subj.Area })]
+			if first, ok := chosen[subj.Code]; ok {
+				errs.add(subjectPath, "%s 와 같은 과목입니다: %q", first, subj.Name)
+			} else {
+				chosen[subj.Code] = subjectPath
+				selected = append(selected, subj)
+			}
+		}
+		if score.RawScore != nil && (*score.RawScore < 0 || *score.RawScore > float64(area.MaxRawScore)) {
+			errs.add(subjectPath+".rawScore", "%s 원점수는 0~%d 사이여야 합니다: %v", area.Name, area.MaxRawScore, *score.RawScore)
+		}
+	}
+
+	for _, rule := range catalog.SuneungRules {
+		if rule.appliesTo("", admissionYear) && rule.violatedBy(selected) {
+			var names []string
+			for _, subj := range selected {
+				if slices.Contains(rule.Areas, subj.Area) || slices.Contains(rule.Subjects, subj.Code) {
+					names = append(names, subj.Name)
+				}
+			}
+			errs.add(path, "%s: %s", rule.Name, strings.Join(names, ", "))
+		}
+	}
+}
+
+// suneungAreaNames 는 영역 이름을 "/" 로 이어 붙입니다. (예: "사회탐구/과학탐구/직업탐구")
+func suneungAreaNames(areas []SuneungArea) string {
+	names := make([]string, len(areas))
+	for i, area := range areas {
+		names[i] = area.Name
+	}
+	return strings.Join(names, "/")
+}
+
+// checkSuneungAreas 는 수능 영역을 검사하고 영역 이름 집합을 반환합니다. (과목의 area 확인용)
+func (k *catalogChecker) checkSuneungAreas(c *SubjectCatalog) map[string]bool {
+	names := map[string]bool{}
+	codes := map[string]string{}
+	seenNames := map[string]string{}
+	for i, area := range c.SuneungAreas {
+		path := fmt.Sprintf("suneungAreas[%d]", i)
+		if area.Code == "" || area.Name == "" {
+			k.add(CatalogIssueInvalidValue, path, area.Code, "code 와 name 이 필요합니다")
+		}
+		if area.MaxRawScore <= 0 {
+			k.add(CatalogIssueInvalidValue, path, area.Code, "maxRawScore 는 0 보다 커야 합니다: %d", area.MaxRawScore)
+		}
+		if !slices.Contains(suneungPayloadKeys, area.PayloadKey) {
+			k.add(CatalogIssueInvalidValue, path, area.Code, "payloadKey 는 %s 중 하나여야 합니다: %q", strings.Join(suneungPayloadKeys, ", "), area.PayloadKey)
+		}
+		k.unique(codes, path, area.Code)
+		if first, ok := seenNames[area.Name]; ok && area.Name != "" {
+			k.add(CatalogIssueNameCollision, path, area.Code, "%s 와 영역 이름이 같습니다: %q", first, area.Name)
+		} else {
+			seenNames[area.Name] = path
+		}
+		names[area.Name] = true
+	}
+	return names
+}
+
+// checkSuneungRules 는 수능 선택 규칙의 종류와 규칙이 가리키는 영역/과목이 있는지 검사합니다.
+func (k *catalogChecker) checkSuneungRules(c *SubjectCatalog, areaNames map[string]bool) {
+	subjectCodes := map[string]bool{}
+	for _, subj := range c.SuneungSubjects {
+		subjectCodes[subj.Code] = true
+	}
+	codes := map[string]string{}
+	for i, rule := range c.SuneungRules {
+		path := fmt.Sprintf("suneungRules[%d]", i)
+		if rule.Code == "" || rule.Name == "" {
+			k.add(CatalogIssueInvalidValue, path, rule.Code, "code 와 name 이 필요합니다")
+		}
+		if problem := rule.CurriculumApplicability.validate(); problem != "" {
+			k.add(CatalogIssueInvalidValue, path, rule.Code, "%s", problem)
+		}
+		k.unique(codes, path, rule.Code)
+		switch rule.Kind {
+		case SuneungRuleMaxCount:
+			if len(rule.Areas) == 0 || rule.Max <= 0 {
+				k.add(CatalogIssueInvalidValue, path, rule.Code, "max_count 규칙은 areas 와 0 보다 큰 max 가 필요합니다")
+			}
+		case SuneungRuleExclusive:
+			if len(rule.Subjects) < 2 && len(rule.Areas) < 2 {
+				k.add(CatalogIssueInvalidValue, path, rule.Code, "exclusive 규칙은 과목이나 영역이 둘 이상 필요합니다")
+			}
+		default:
+			k.add(CatalogIssueInvalidValue, path, rule.Code, "kind 는 %s, %s 중 하나여야 합니다: %q", SuneungRuleMaxCount, SuneungRuleExclusive, rule.Kind)
+		}
+		for _, area := range rule.Areas {
+			if !areaNames[area] {
+				k.add(CatalogIssueOrphanParent, path, rule.Code, "없는 수능 영역입니다: %q", area)
+			}
+		}
+		for _, code := range rule.Subjects {
+			if !subjectCodes[code] {
+				k.add(CatalogIssueOrphanParent, path, rule.Code, "없는 수능 과목 코드입니다: %q", code)
+			}
+		}
+	}
+}
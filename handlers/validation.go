@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// --- 성적 입력 검증 ---
+// FilterUniversities 요청의 성적 값이 범위를 벗어나거나 과목 목록에 없는 코드를 쓰면
+// 계산 전에 걸러 내고, 어느 필드가 잘못되었는지 JSON 경로로 알려 줍니다.
+
+// 내신 성적 허용 범위
+const (
+	minNaesinGrade = 1
+	maxRawScore    = 100
+)
+
+// semesterKeyPattern 은 내신 학기 키("학년-학기") 형식입니다. 학년은 1~3, 학기는 1~2 입니다.
+var semesterKeyPattern = regexp.MustCompile(`^[1-3]-[1-2]$`)
+
+// achievementLevels 는 허용하는 성취도 값입니다.
+var achievementLevels = map[string]bool{"A": true, "B": true, "C": true, "D": true, "E": true}
+
+// FieldError 는 요청 본문의 필드 하나에 대한 검증 오류입니다.
+type FieldError struct {
+	Path    string `json:"path"` // 예: userGrades.naesin["1-1"][0].grade
+	Message string `json:"message"`
+}
+
+// fieldErrors 는 검증 오류를 모읍니다.
+type fieldErrors []FieldError
+
+func (e *fieldErrors) add(path, format string, args ...interface{}) {
+	*e = append(*e, FieldError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// naesinCatalogCodes 는 과목 목록에 있는 코드 -> 교육과정 버전 맵입니다.
+type naesinCatalogCodes struct {
+	classifications map[string]string
+	curriculums     map[string]string
+	subjects        map[string]string
+}
+
+// Validate 는 요청의 성적과 필터 조건을 검사하여 잘못된 필드 목록을 반환합니다. 문제가 없으면 nil 입니다.
+// 내신 과목 코드는 catalog(현재 과목 목록)에 있는지, 수능 과목은 영역과 선택 규칙에 맞는지 확인합니다.
+func (p *FilterPayload) Validate(catalog *SubjectCatalog) []FieldError {
+	var errs fieldErrors
+	version := p.UserGrades.CurriculumVersion
+	if version == "" {
+		version = DefaultCurriculumVersion
+	} else if !IsCurriculumVersion(version) {
+		errs.add("userGrades.curriculumVersion", "교육과정 버전은 %v 중 하나여야 합니다: %q", curriculumVersions, version)
+		version = DefaultCurriculumVersion
+	}
+	validateNaesinGrades(&errs, "userGrades.naesin", p.UserGrades.Naesin, catalog.codes, version)
+	validateSuneungGrades(&errs, "userGrades.suneung", p.UserGrades.Suneung, catalog)
+	if p.FilterCriteria.ScoreDifferenceTolerance < 0 {
+		errs.add("filterCriteria.scoreDifferenceTolerance", "0 이상이어야 합니다.")
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func validateNaesinGrades(errs *fieldErrors, path string, grades NaesinGrades, codes naesinCatalogCodes, version string) {
+	// 오류 순서가 요청마다 바뀌지 않도록 학기 키를 정렬해서 검사합니다.
+	keys := make([]string, 0, len(grades))
+	for key := range grades {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		semesterPath := path + "[" + strconv.Quote(key) + "]"
+		if !semesterKeyPattern.MatchString(key) {
+			errs.add(semesterPath, "학기 키는 \"학년-학기\" 형식(1-1 ~ 3-2)이어야 합니다: %q", key)
+			continue
+		}
+		for i, subject := range grades[key] {
+			validateNaesinSubject(errs, fmt.Sprintf("%s[%d]", semesterPath, i), subject, codes, version)
+		}
+	}
+}
+
+func validateNaesinSubject(errs *fieldErrors, path string, s NaesinSubject, codes naesinCatalogCodes, version string) {
+	// 2022 개정 교육과정은 5등급제, 그 이전은 9등급제입니다.
+	if maxGrade := RankScaleForCurriculum(version); s.Grade != nil && (*s.Grade < minNaesinGrade || *s.Grade > maxGrade) {
+		errs.add(path+".grade", "%s 개정 교육과정의 등급은 %d~%d 사이여야 합니다: %d", version, minNaesinGrade, maxGrade, *s.Grade)
+	}
+	if s.Credits != nil && *s.Credits <= 0 {
+		errs.add(path+".credits", "단위수는 0보다 커야 합니다: %g", *s.Credits)
+	}
+	if s.RawScore != nil && (*s.RawScore < 0 || *s.RawScore > maxRawScore) {
+		errs.add(path+".rawScore", "원점수는 0~%d 사이여야 합니다: %g", maxRawScore, *s.RawScore)
+	}
+	if s.SubjectMean != nil && (*s.SubjectMean < 0 || *s.SubjectMean > maxRawScore) {
+		errs.add(path+".subjectMean", "과목평균은 0~%d 사이여야 합니다: %g", maxRawScore, *s.SubjectMean)
+	}
+	if s.StdDev != nil && *s.StdDev < 0 {
+		errs.add(path+".stdDev", "표준편차는 0 이상이어야 합니다: %g", *s.StdDev)
+	}
+	if s.StudentCount != nil && *s.StudentCount < 1 {
+		errs.add(path+".studentCount", "수강자수는 1 이상이어야 합니다: %d", *s.StudentCount)
+	}
+	if s.AchievementLevel != nil && *s.AchievementLevel != "" && !achievementLevels[*s.AchievementLevel] {
+		errs.add(path+".achievementLevel", "성취도는 A~E 중 하나여야 합니다: %q", *s.AchievementLevel)
+	}
+
+	// 성취도별 분포비율(%)은 각각 0~100 이고, 합이 100 을 넘을 수 없습니다.
+	var distributionSum float64
+	for _, d := range []struct {
+		field string
+		value *float64
+	}{{"distributionA", s.DistributionA}, {"distributionB", s.DistributionB}, {"distributionC", s.DistributionC}} {
+		if d.value == nil {
+			continue
+		}
+		if *d.value < 0 || *d.value > 100 {
+			errs.add(path+"."+d.field, "분포비율은 0~100 사이여야 합니다: %g", *d.value)
+		}
+		distributionSum += *d.value
+	}
+	if distributionSum > 100+1e-6 {
+		errs.add(path, "성취도별 분포비율의 합이 100을 넘습니다: %g", distributionSum)
+	}
+
+	checkCatalogCode(errs, path+".curriculumClassificationCode", s.CurriculumClassificationCode, codes.classifications, version, "알 수 없는 교과구분종류 코드입니다")
+	checkCatalogCode(errs, path+".curriculumAreaCode", s.CurriculumAreaCode, codes.curriculums, version, "알 수 없는 교과 코드입니다")
+	checkCatalogCode(errs, path+".subjectCode", s.SubjectCode, codes.subjects, version, "과목 목록에 없는 과목 코드입니다")
+}
+
+// checkCatalogCode 는 code 가 과목 목록에 있고, 성적 입력의 교육과정 버전(version)과 함께 쓸 수 있는지 확인합니다.
+func checkCatalogCode(errs *fieldErrors, path string, code *string, versions map[string]string, version, unknownMessage string) {
+	if code == nil || *code == "" {
+		return
+	}
+	codeVersion, ok := versions[*code]
+	if !ok {
+		errs.add(path, "%s: %q", unknownMessage, *code)
+		return
+	}
+	if !slices.Contains(compatibleCurriculumVersions[version], codeVersion) {
+		errs.add(path, "%s 개정 교육과정 항목은 %s 개정 교육과정 성적에 쓸 수 없습니다: %q", codeVersion, version, *code)
+	}
+}
+
+func validateSuneungGrades(errs *fieldErrors, path string, s SuneungGrades, catalog *SubjectCatalog) {
+	// 수능 성적을 입력하지 않으면 examYear/examMonth 는 0 입니다.
+	if s.ExamMonth != 0 && (s.ExamMonth < 1 || s.ExamMonth > 12) {
+		errs.add(path+".examMonth", "시행 월은 1~12 사이여야 합니다: %d", s.ExamMonth)
+	}
+	if s.ExamYear < 0 {
+		errs.add(path+".examYear", "시행 연도가 올바르지 않습니다: %d", s.ExamYear)
+	}
+	// 수능(과 고3 모의평가)은 시행 연도의 다음 해 대입에 쓰므로, 그 학년도의 과목과 선택 규칙으로 확인합니다.
+	admissionYear := 0
+	if s.ExamYear > 0 {
+		admissionYear = s.ExamYear + 1
+	}
+	validateSuneungSubjects(errs, path+".subjects", s.Subjects, catalog, admissionYear)
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// --- XLSX 읽기 ---
+// 내신 성적 파일(POST /api/naesin/import)에서 첫 번째 시트의 셀 값만 읽으면 되므로, 외부 라이브러리 없이
+// XLSX(zip 안의 XML)에서 공유 문자열과 시트 셀만 꺼내는 최소한의 리더입니다. 서식, 수식 계산, 병합 셀은 다루지 않습니다.
+// (수식 셀은 파일에 저장된 마지막 계산 값을 읽습니다)
+
+// xlsxMaxPartSize 는 zip 안의 XML 파일 하나를 풀었을 때의 최대 크기입니다. (압축 폭탄 방지)
+const xlsxMaxPartSize = 32 << 20
+
+// errLegacyXLS 는 XLSX 가 아닌 예전 XLS(97-2003, OLE2) 파일입니다.
+var errLegacyXLS = errors.New("XLS(97-2003) 형식은 지원하지 않습니다. 엑셀에서 XLSX 또는 CSV 로 저장한 뒤 올려 주세요")
+
+// 파일 형식을 구분하는 시그니처
+var (
+	xlsxSignature = []byte("PK\x03\x04")
+	xlsSignature  = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+)
+
+// readXLSXRows 는 XLSX 파일의 첫 번째 시트를 행 × 열 문자열로 읽습니다. 빈 셀은 "" 이고, 행마다 길이가 다를 수 있습니다.
+func readXLSXRows(data []byte) ([][]string, error) {
+	if bytes.HasPrefix(data, xlsSignature) {
+		return nil, errLegacyXLS
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("XLSX 파일을 열 수 없습니다: %w", err)
+	}
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := xlsxFirstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := xlsxSharedStrings(files)
+	if err != nil {
+		return nil, err
+	}
+
+	var sheet struct {
+		Rows []struct {
+			Index int `xml:"r,attr"`
+			Cells []struct {
+				Ref    string       `xml:"r,attr"`
+				Type   string       `xml:"t,attr"`
+				Value  string       `xml:"v"`
+				Inline xlsxRichText `xml:"is"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := xlsxDecodePart(files, sheetPath, &sheet); err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	for _, row := range sheet.Rows {
+		// r 속성이 있으면 그 행 번호에 맞춰 빈 행을 채웁니다. (엑셀은 빈 행을 저장하지 않음)
+		for row.Index > len(rows)+1 {
+			rows = append(rows, nil)
+		}
+		var values []string
+		for i, cell := range row.Cells {
+			col := i
+			if cell.Ref != "" {
+				if c, ok := xlsxColumnIndex(cell.Ref); ok {
+					col = c
+				}
+			}
+			for len(values) <= col {
+				values = append(values, "")
+			}
+			switch cell.Type {
+			case "s":
+				n, err := strconv.Atoi(cell.Value)
+				if err != nil || n < 0 || n >= len(shared) {
+					return nil, fmt.Errorf("XLSX 셀 %s 의 공유 문자열 번호가 올바르지 않습니다: %q", cell.Ref, cell.Value)
+				}
+				values[col] = shared[n]
+			case "inlineStr":
+				values[col] = cell.Inline.String()
+			case "b":
+				values[col] = map[string]string{"1": "TRUE", "0": "FALSE"}[cell.Value]
+			default: // n, str, e, 또는 생략(숫자)
+				values[col] = cell.Value
+			}
+		}
+		rows = append(rows, values)
+	}
+	return rows, nil
+}
+
+// xlsxRichText 는 공유 문자열/인라인 문자열 하나입니다. 서식이 섞인 문자열은 여러 <r><t> 조각으로 나뉩니다.
+type xlsxRichText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxRichText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var b strings.Builder
+	b.WriteString(t.Text)
+	for _, r := range t.Runs {
+		b.WriteString(r.Text)
+	}
+	return b.String()
+}
+
+// xlsxFirstSheetPath 는 workbook.xml 의 첫 번째 시트가 가리키는 zip 안의 경로입니다. (예: "xl/worksheets/sheet1.xml")
+func xlsxFirstSheetPath(files map[string]*zip.File) (string, error) {
+	var workbook struct {
+		Sheets []struct {
+			RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := xlsxDecodePart(files, "xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", errors.New("XLSX 파일에 시트가 없습니다")
+	}
+	var rels struct {
+		Items []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := xlsxDecodePart(files, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Items {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		// Target 은 보통 xl/ 기준 상대 경로("worksheets/sheet1.xml")이고, 가끔 절대 경로("/xl/worksheets/sheet1.xml")입니다.
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", errors.New("XLSX 파일에서 첫 번째 시트를 찾을 수 없습니다")
+}
+
+// xlsxSharedStrings 는 공유 문자열 표를 읽습니다. 문자열 셀이 없는 파일에는 표가 없을 수 있습니다.
+func xlsxSharedStrings(files map[string]*zip.File) ([]string, error) {
+	if _, ok := files["xl/sharedStrings.xml"]; !ok {
+		return nil, nil
+	}
+	var sst struct {
+		Items []xlsxRichText `xml:"si"`
+	}
+	if err := xlsxDecodePart(files, "xl/sharedStrings.xml", &sst); err != nil {
+		return nil, err
+	}
+	out := make([]string, len(sst.Items))
+	for i, item := range sst.Items {
+		out[i] = item.String()
+	}
+	return out, nil
+}
+
+// xlsxDecodePart 는 zip 안의 XML 파일 하나를 v 로 읽습니다.
+func xlsxDecodePart(files map[string]*zip.File, name string, v interface{}) error {
+	f, ok := files[name]
+	if !ok {
+		return fmt.Errorf("XLSX 파일에 %s 가 없습니다", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("XLSX %s 열기 실패: %w", name, err)
+	}
+	defer rc.Close()
+	lr := &io.LimitedReader{R: rc, N: xlsxMaxPartSize + 1}
+	if err := xml.NewDecoder(lr).Decode(v); err != nil {
+		return fmt.Errorf("XLSX %s 파싱 실패: %w", name, err)
+	}
+	if lr.N <= 0 {
+		return fmt.Errorf("XLSX %s 가 너무 큽니다", name)
+	}
+	return nil
+}
+
+// xlsxColumnIndex 는 셀 참조("C12")의 열 번호(0부터)를 구합니다.
+func xlsxColumnIndex(ref string) (int, bool) {
+	col := 0
+	n := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+		n++
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return col - 1, true
+}
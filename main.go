@@ -3,53 +3,214 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	"univ/config"
 	"univ/handlers" // 프로젝트 모듈 이름이 'univ'라고 가정
 
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
-	// --- 1. 초기화 작업 ---
-	handlers.InitDB()
-	defer handlers.CloseDB()
-
-	departmentInfoPath := "data/departments.csv"
-	admissionResultPath := "data/adiga_2025_admission_results_final.csv"
-	handlers.LoadAdmissionData(departmentInfoPath, admissionResultPath)
-
-	// --- 2. Gin 엔진 및 라우터 설정 ---
-	r := gin.Default()
-	r.Use(func(c *gin.Context) {
-		// (CORS 설정은 그대로 둡니다)
-		c.Writer.Header().Set("Access-Control-Allow-Origin", "*") 
-		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(204)
+	// --- 0. 서브커맨드 ---
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "import":
+			runImport(os.Args[2:])
+			return
+		case "migrate":
+			runMigrate(os.Args[2:])
+			return
+		case "import-subjects":
+			runImportSubjects(os.Args[2:])
+			return
+		case "export-subjects":
+			runExportSubjects(os.Args[2:])
+			return
+		case "check-subjects":
+			runCheckSubjects(os.Args[2:])
+			return
+		case "parse-naesin":
+			runParseNaesin(os.Args[2:])
 			return
 		}
-		c.Next()
-	})
+	}
 
-	api := r.Group("/api")
-	{
-		api.POST("/universities/filter", handlers.FilterUniversities)
-		api.GET("/subjects", gin.WrapF(handlers.Subject))
-		api.GET("/map/initial-data", gin.WrapF(handlers.GetUniversitiesHandler))
+	// --- 1. 설정 로드 (기본값 < 설정 파일 < 환경 변수 < 플래그) ---
+	fs := flag.NewFlagSet("univ", flag.ExitOnError)
+	cfg := loadConfig(fs, os.Args[1:])
+	if cfg.Log.Level == "debug" {
+		gin.SetMode(gin.DebugMode)
+	} else {
+		gin.SetMode(gin.ReleaseMode)
 	}
 
-	r.NoRoute(func(c *gin.Context) {
-		c.Status(http.StatusNotFound)
-	})
+	// SIGINT/SIGTERM 을 받으면 ctx 가 취소되고, 처리 중인 요청을 마친 뒤 종료합니다.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// --- 2. 초기화 작업 ---
+	store := openStore(cfg)
+	defer func() {
+		store.Close()
+		slog.Info("DB 연결을 닫았습니다")
+	}()
+
+	opts := serverOptions(cfg, store)
+	server := handlers.NewServer(opts)
 
-	// --- 3. 서버 실행 (Nginx 뒤에서 실행) ---
+	if opts.AdmissionSource == handlers.AdmissionSourceDB {
+		slog.Info("입시 결과를 DB(admission_rules)에서 조회합니다", "dialect", store.Dialect)
+		if err := checkAdmissionRows(store, opts.Thresholds); err != nil {
+			fatal("서버 시작 중단", err)
+		}
+	} else {
+		report := server.LoadAdmissionData(cfg.Data.DepartmentsFile(), cfg.Data.AdmissionResultsFile())
+		report.Log(slog.Default())
+		if err := report.CheckThresholds(opts.Thresholds); err != nil {
+			fatal("서버 시작 중단", err)
+		}
+
+		// data.watchInterval (예: "30s")을 지정하면 CSV 파일 변경 시 자동으로 다시 로드합니다.
+		if interval := time.Duration(cfg.Data.WatchInterval); interval > 0 {
+			go server.WatchAdmissionData(ctx, interval)
+		}
+	}
+	checkSubjectCatalogOnStartup(server, cfg.Subjects.CheckOnStartup)
+	if !cfg.Admin.Enabled() {
+		slog.Warn("관리자 계정이 설정되지 않아 /api/admin 을 사용할 수 없습니다 (UNIV_ADMIN_USER / UNIV_ADMIN_PASSWORD)")
+	}
+
+	// --- 3. Gin 엔진 및 라우터 설정 ---
+	r := server.Router()
+
+	// --- 4. 서버 실행 (Nginx 뒤에서 실행) ---
 	// Nginx가 SSL 처리와 리디렉션을 모두 담당하므로,
-	// Go 애플리케이션은 내부 포트(예: 8080)에서 간단한 HTTP 서버로만 실행합니다.
-	log.Println("Starting server on internal port :8080")
-	if err := r.Run(":8080"); err != nil {
-		log.Fatalf("Failed to run server: %v", err)
+	// Go 애플리케이션은 내부 포트(기본값 :8080)에서 간단한 HTTP 서버로만 실행합니다.
+	srv := &http.Server{Addr: cfg.Server.Addr, Handler: r}
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("서버 시작", "addr", cfg.Server.Addr)
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			fatal("서버 실행 실패", err)
+		}
+	case <-ctx.Done():
+		stop() // 두 번째 신호는 기본 동작(즉시 종료)을 따르게 합니다.
+		timeout := time.Duration(cfg.Server.ShutdownTimeout)
+		slog.Info("종료 신호를 받았습니다. 처리 중인 요청을 기다립니다", "timeout", timeout.String())
+		server.BeginShutdown()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			slog.Error("정상 종료 실패, 남은 연결을 끊습니다", "error", err)
+			srv.Close()
+		}
+		slog.Info("서버를 종료했습니다")
+	}
+}
+
+// loadConfig 는 fs 에 설정 플래그를 등록하고 args 를 파싱한 뒤 설정을 읽습니다. 설정이 올바르지 않으면 종료합니다.
+// 설정의 log.level / log.format 으로 기본 로거(slog.Default, 표준 log 패키지 포함)를 설정합니다.
+func loadConfig(fs *flag.FlagSet, args []string) *config.Config {
+	flags := config.RegisterFlags(fs)
+	fs.Parse(args)
+	cfg, err := config.Load(flags)
+	if err != nil {
+		fatal("설정 로드 실패", err)
+	}
+	slog.SetDefault(handlers.NewLogger(os.Stderr, cfg.Log.Level, cfg.Log.Format))
+	return cfg
+}
+
+// fatal 은 에러를 로그에 남기고 프로세스를 종료합니다.
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// checkAdmissionRows 는 data.source=db 일 때 DB에 적재된 입시 결과가 최소 개수 이상인지 확인합니다.
+// (CSV 로드와 달리 매칭/변환 실패는 `univ import` 때 이미 걸러졌으므로 행 수만 봅니다)
+func checkAdmissionRows(store *handlers.Store, t handlers.LoadThresholds) error {
+	if t.MinLoadedRows <= 0 {
+		return nil
+	}
+	n, err := store.Admissions.CountAdmissions()
+	if err != nil {
+		return err
+	}
+	if n < t.MinLoadedRows {
+		return fmt.Errorf("DB의 입시 결과 %d건 < 최소 %d건 (univ import 로 적재하세요)", n, t.MinLoadedRows)
+	}
+	return nil
+}
+
+// openStore 는 설정의 DB에 연결하고, 적용되지 않은 마이그레이션을 적용합니다.
+func openStore(cfg *config.Config) *handlers.Store {
+	store := openStoreWithoutMigrations(cfg)
+	applied, err := store.Migrate()
+	if err != nil {
+		fatal("DB 마이그레이션 에러", err)
+	}
+	for _, m := range applied {
+		slog.Info("DB 마이그레이션 적용", "version", m.Version, "name", m.Name)
+	}
+	return store
+}
+
+// openStoreWithoutMigrations 는 마이그레이션 없이 DB에 연결합니다. (`univ migrate` 용)
+func openStoreWithoutMigrations(cfg *config.Config) *handlers.Store {
+	store, err := handlers.OpenStore(cfg.Database.Driver, cfg.Database.DSN)
+	if err != nil {
+		fatal("DB 연결 에러", err)
+	}
+	slog.Info("데이터베이스에 연결되었습니다", "dialect", store.Dialect)
+	return store
+}
+
+// serverOptions 는 설정으로 handlers.ServerOptions 를 만듭니다.
+func serverOptions(cfg *config.Config, store *handlers.Store) handlers.ServerOptions {
+	opts := handlers.ServerOptions{
+		Config:          cfg,
+		Store:           store,
+		AdmissionSource: handlers.AdmissionSourceCSV,
+		CSVSpecs:        csvSpecs(cfg),
+		Logger:          slog.Default(),
+		Thresholds: handlers.LoadThresholds{
+			MinLoadedRows:       cfg.Data.Thresholds.MinAdmissionRows,
+			MaxUnmatchedRatio:   cfg.Data.Thresholds.MaxUnmatchedRatio,
+			MaxUnparseableRatio: cfg.Data.Thresholds.MaxUnparseableRatio,
+		},
+	}
+	// data.source=db 이면 `univ import` 로 적재한 DB 테이블(SQLite 또는 PostgreSQL)에서 조회하고,
+	// 그 외(기본값 csv)에는 시작 시 CSV를 메모리에 로드합니다. (sqlite 는 db 와 같은 뜻으로 계속 받습니다.)
+	if cfg.Data.Source == "db" || cfg.Data.Source == "sqlite" {
+		opts.AdmissionSource = handlers.AdmissionSourceDB
+	}
+	return opts
+}
+
+// csvSpecs 는 data.columnSpecPath 에 지정한 CSV 열 정의 파일을 읽습니다. 지정하지 않으면 nil(기본값)입니다.
+func csvSpecs(cfg *config.Config) *handlers.CSVSpecs {
+	if cfg.Data.ColumnSpecPath == "" {
+		return nil
+	}
+	specs, err := handlers.LoadCSVColumnSpecs(cfg.Data.ColumnSpecPath)
+	if err != nil {
+		fatal("CSV 열 정의 로드 실패", err)
 	}
-}
\ No newline at end of file
+	return &specs
+}
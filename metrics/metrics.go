@@ -0,0 +1,330 @@
+// Package metrics 는 Prometheus 텍스트 형식(0.0.4)으로 내보낼 수 있는 카운터, 히스토그램, 게이지를 제공합니다.
+//
+// 외부 라이브러리나 수집 서버 없이 표준 라이브러리만으로 동작하므로, 테스트에서도 Registry 를 새로 만들어
+// WriteText 결과를 그대로 확인할 수 있습니다. 레이블 값마다 시계열이 하나씩 생기므로
+// 요청 경로처럼 값이 많아질 수 있는 레이블에는 원본 URL 대신 경로 패턴을 넣어야 합니다.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ContentType 은 WriteText 출력의 Content-Type 입니다.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultDurationBuckets 는 처리 시간(초) 히스토그램의 기본 구간입니다. (1ms ~ 10s)
+var DefaultDurationBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Registry 는 내보낼 지표 목록입니다.
+type Registry struct {
+	mu         sync.Mutex
+	collectors []collector
+	names      map[string]bool
+}
+
+type collector interface {
+	name() string
+	write(w *bufio.Writer)
+}
+
+// NewRegistry 는 빈 Registry 를 만듭니다.
+func NewRegistry() *Registry {
+	return &Registry{names: map[string]bool{}}
+}
+
+func (r *Registry) register(c collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.names[c.name()] {
+		panic("metrics: 같은 이름의 지표가 이미 등록되어 있습니다: " + c.name())
+	}
+	r.names[c.name()] = true
+	r.collectors = append(r.collectors, c)
+}
+
+// WriteText 는 등록된 모든 지표를 Prometheus 텍스트 형식으로 씁니다. 지표는 등록한 순서로 출력됩니다.
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.Lock()
+	collectors := append([]collector(nil), r.collectors...)
+	r.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+	for _, c := range collectors {
+		c.write(bw)
+	}
+	return bw.Flush()
+}
+
+// --- Counter ---
+
+// CounterVec 은 레이블 값별 카운터 묶음입니다.
+type CounterVec struct {
+	desc
+	series seriesMap[*Counter]
+}
+
+// Counter 는 증가만 하는 값입니다.
+type Counter struct {
+	bits atomic.Uint64
+}
+
+// NewCounterVec 은 카운터를 등록합니다.
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	v := &CounterVec{desc: desc{Name: name, Help: help, Labels: labels}}
+	v.series.init(func() *Counter { return &Counter{} })
+	r.register(v)
+	return v
+}
+
+// With 는 레이블 값(등록한 레이블 순서)에 해당하는 카운터를 반환합니다.
+func (v *CounterVec) With(labelValues ...string) *Counter {
+	return v.series.get(v.desc, labelValues)
+}
+
+// Inc 는 1 증가시킵니다.
+func (c *Counter) Inc() { c.Add(1) }
+
+// Add 는 delta(0 이상)만큼 증가시킵니다.
+func (c *Counter) Add(delta float64) {
+	if delta < 0 {
+		return
+	}
+	for {
+		old := c.bits.Load()
+		if c.bits.CompareAndSwap(old, math.Float64bits(math.Float64frombits(old)+delta)) {
+			return
+		}
+	}
+}
+
+// Value 는 현재 값을 반환합니다.
+func (c *Counter) Value() float64 {
+	return math.Float64frombits(c.bits.Load())
+}
+
+func (v *CounterVec) write(w *bufio.Writer) {
+	v.header(w, "counter")
+	v.series.each(func(labelValues []string, c *Counter) {
+		v.sample(w, "", labelValues, nil, c.Value())
+	})
+}
+
+// --- Histogram ---
+
+// HistogramVec 은 레이블 값별 히스토그램 묶음입니다.
+type HistogramVec struct {
+	desc
+	buckets []float64
+	series  seriesMap[*Histogram]
+}
+
+// Histogram 은 관측값의 분포(구간별 누적 개수, 합계, 개수)입니다.
+type Histogram struct {
+	mu      sync.Mutex
+	buckets []float64
+	counts  []uint64 // 구간별 개수 (누적 아님)
+	sum     float64
+	count   uint64
+}
+
+// NewHistogramVec 은 히스토그램을 등록합니다. buckets 는 오름차순 상한값입니다. (+Inf 는 자동으로 추가)
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	v := &HistogramVec{desc: desc{Name: name, Help: help, Labels: labels}, buckets: buckets}
+	v.series.init(func() *Histogram {
+		return &Histogram{buckets: buckets, counts: make([]uint64, len(buckets))}
+	})
+	r.register(v)
+	return v
+}
+
+// With 는 레이블 값(등록한 레이블 순서)에 해당하는 히스토그램을 반환합니다.
+func (v *HistogramVec) With(labelValues ...string) *Histogram {
+	return v.series.get(v.desc, labelValues)
+}
+
+// Observe 는 값 하나를 기록합니다.
+func (h *Histogram) Observe(value float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if i := sort.SearchFloat64s(h.buckets, value); i < len(h.buckets) {
+		h.counts[i]++
+	}
+	h.sum += value
+	h.count++
+}
+
+// ObserveDuration 은 start 부터 지금까지의 시간을 초 단위로 기록합니다.
+func (h *Histogram) ObserveDuration(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+// Count 는 지금까지 기록한 값의 개수입니다.
+func (h *Histogram) Count() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count
+}
+
+func (v *HistogramVec) write(w *bufio.Writer) {
+	v.header(w, "histogram")
+	v.series.each(func(labelValues []string, h *Histogram) {
+		h.mu.Lock()
+		counts := append([]uint64(nil), h.counts...)
+		sum, count := h.sum, h.count
+		h.mu.Unlock()
+
+		var cumulative uint64
+		for i, upper := range v.buckets {
+			cumulative += counts[i]
+			v.sample(w, "_bucket", labelValues, []string{"le", formatFloat(upper)}, float64(cumulative))
+		}
+		v.sample(w, "_bucket", labelValues, []string{"le", "+Inf"}, float64(count))
+		v.sample(w, "_sum", labelValues, nil, sum)
+		v.sample(w, "_count", labelValues, nil, float64(count))
+	})
+}
+
+// --- Gauge ---
+
+// gaugeFunc 은 내보낼 때마다 fn 을 호출해 값을 읽는 게이지입니다.
+type gaugeFunc struct {
+	desc
+	fn func() float64
+}
+
+// NewGaugeFunc 은 내보낼 때마다 fn 으로 값을 읽는 게이지를 등록합니다. (데이터 건수, 고루틴 수 등)
+func (r *Registry) NewGaugeFunc(name, help string, fn func() float64) {
+	r.register(&gaugeFunc{desc: desc{Name: name, Help: help}, fn: fn})
+}
+
+func (g *gaugeFunc) write(w *bufio.Writer) {
+	g.header(w, "gauge")
+	g.sample(w, "", nil, nil, g.fn())
+}
+
+// --- 공통 ---
+
+// desc 는 지표 이름, 설명, 레이블 이름입니다.
+type desc struct {
+	Name   string
+	Help   string
+	Labels []string
+}
+
+func (d desc) name() string { return d.Name }
+
+func (d desc) header(w *bufio.Writer, typ string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", d.Name, escapeHelp(d.Help), d.Name, typ)
+}
+
+// sample 은 시계열 한 줄을 씁니다. extra 는 히스토그램의 le 처럼 추가 레이블 (이름, 값) 한 쌍입니다.
+func (d desc) sample(w *bufio.Writer, suffix string, labelValues, extra []string, value float64) {
+	w.WriteString(d.Name)
+	w.WriteString(suffix)
+	if len(labelValues) > 0 || len(extra) > 0 {
+		w.WriteByte('{')
+		for i, label := range d.Labels {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", label, escapeLabelValue(labelValues[i]))
+		}
+		if len(extra) == 2 {
+			if len(d.Labels) > 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", extra[0], extra[1])
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(value))
+	w.WriteByte('\n')
+}
+
+// seriesMap 은 레이블 값 조합별 시계열입니다.
+type seriesMap[T any] struct {
+	mu     sync.RWMutex
+	newFn  func() T
+	values map[string]T
+	labels map[string][]string
+}
+
+func (m *seriesMap[T]) init(newFn func() T) {
+	m.newFn = newFn
+	m.values = map[string]T{}
+	m.labels = map[string][]string{}
+}
+
+func (m *seriesMap[T]) get(d desc, labelValues []string) T {
+	if len(labelValues) != len(d.Labels) {
+		panic(fmt.Sprintf("metrics: %s 의 레이블 값 개수가 맞지 않습니다 (필요 %d, 받음 %d)", d.Name, len(d.Labels), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+
+	m.mu.RLock()
+	v, ok := m.values[key]
+	m.mu.RUnlock()
+	if ok {
+		return v
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if v, ok := m.values[key]; ok {
+		return v
+	}
+	v = m.newFn()
+	m.values[key] = v
+	m.labels[key] = append([]string(nil), labelValues...)
+	return v
+}
+
+// each 는 레이블 값 순으로 정렬해 시계열을 순회합니다.
+func (m *seriesMap[T]) each(fn func(labelValues []string, v T)) {
+	m.mu.RLock()
+	keys := make([]string, 0, len(m.values))
+	for key := range m.values {
+		keys = append(keys, key)
+	}
+	m.mu.RUnlock()
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		m.mu.RLock()
+		v, labels := m.values[key], m.labels[key]
+		m.mu.RUnlock()
+		fn(labels, v)
+	}
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string       { return helpEscaper.Replace(s) }
+func escapeLabelValue(s string) string { return labelEscaper.Replace(s) }
@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeText(t *testing.T, r *Registry) string {
+	t.Helper()
+	var b strings.Builder
+	require.NoError(t, r.WriteText(&b))
+	return b.String()
+}
+
+func TestCounterVec(t *testing.T) {
+	r := NewRegistry()
+	requests := r.NewCounterVec("test_requests_total", "요청 수", "method", "status")
+	requests.With("GET", "200").Inc()
+	requests.With("GET", "200").Add(2)
+	requests.With("POST", "400").Inc()
+	requests.With("POST", "400").Add(-1) // 감소는 무시합니다
+
+	assert.Equal(t, 3.0, requests.With("GET", "200").Value())
+	assert.Equal(t, `# HELP test_requests_total 요청 수
+# TYPE test_requests_total counter
+test_requests_total{method="GET",status="200"} 3
+test_requests_total{method="POST",status="400"} 1
+`, writeText(t, r))
+}
+
+func TestHistogramVec(t *testing.T) {
+	r := NewRegistry()
+	sizes := r.NewHistogramVec("test_sizes", "크기", []float64{10, 1, 5}, "kind")
+	for _, v := range []float64{0.5, 1, 3, 7, 100} {
+		sizes.With("a").Observe(v)
+	}
+	assert.Equal(t, uint64(5), sizes.With("a").Count())
+
+	// 구간은 정렬되고, 개수는 누적되며, 상한값과 같은 값은 그 구간에 들어갑니다.
+	assert.Equal(t, `# HELP test_sizes 크기
+# TYPE test_sizes histogram
+test_sizes_bucket{kind="a",le="1"} 2
+test_sizes_bucket{kind="a",le="5"} 3
+test_sizes_bucket{kind="a",le="10"} 4
+test_sizes_bucket{kind="a",le="+Inf"} 5
+test_sizes_sum{kind="a"} 111.5
+test_sizes_count{kind="a"} 5
+`, writeText(t, r))
+}
+
+func TestHistogramWithoutLabels(t *testing.T) {
+	r := NewRegistry()
+	h := r.NewHistogramVec("test_plain", "레이블 없음", []float64{1})
+	h.With().Observe(2)
+	assert.Contains(t, writeText(t, r), "test_plain_bucket{le=\"1\"} 0\ntest_plain_bucket{le=\"+Inf\"} 1\ntest_plain_sum 2\ntest_plain_count 1\n")
+}
+
+func TestGaugeFuncAndEscaping(t *testing.T) {
+	r := NewRegistry()
+	r.NewGaugeFunc("test_nan", "줄\n바꿈", func() float64 { return math.NaN() })
+	r.NewCounterVec("test_escape_total", "이스케이프", "path").With(`a"b\c`).Inc()
+
+	out := writeText(t, r)
+	assert.Contains(t, out, "# HELP test_nan 줄\\n바꿈\n# TYPE test_nan gauge\ntest_nan NaN\n")
+	assert.Contains(t, out, `test_escape_total{path="a\"b\\c"} 1`)
+	assert.Less(t, strings.Index(out, "test_nan"), strings.Index(out, "test_escape_total"), "등록한 순서로 출력합니다")
+}
+
+func TestRegistryPanics(t *testing.T) {
+	r := NewRegistry()
+	v := r.NewCounterVec("test_total", "", "a")
+	assert.Panics(t, func() { r.NewCounterVec("test_total", "") }, "같은 이름")
+	assert.Panics(t, func() { v.With("x", "y") }, "레이블 값 개수")
+}
@@ -0,0 +1,244 @@
+// Package migrations 는 DB 스키마를 소스에 포함된 SQL 파일로 관리합니다.
+//
+// SQL 문법이 DB마다 조금씩 다르므로 sqlite/, postgres/ 디렉토리에 같은 버전의 파일을 각각 둡니다.
+// 파일 이름은 "<버전>_<이름>.up.sql" / "<버전>_<이름>.down.sql" 형식이며 (예: 0003_create_departments_admission_rules.up.sql),
+// 적용된 버전은 schema_migrations 테이블에 기록됩니다. 각 마이그레이션은 하나의 트랜잭션으로 실행됩니다.
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed sqlite/*.sql postgres/*.sql
+var files embed.FS
+
+// Dialect 는 마이그레이션을 적용할 DB 종류입니다. 값은 SQL 파일 디렉토리 이름과 같습니다.
+type Dialect string
+
+const (
+	SQLite   Dialect = "sqlite"
+	Postgres Dialect = "postgres"
+)
+
+// Bind 는 "?" 자리표시자를 DB에 맞게 바꿉니다. (PostgreSQL 은 $1, $2, ...)
+func (d Dialect) Bind(query string) string {
+	if d != Postgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Migration 은 버전 하나의 up/down SQL 입니다.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status 는 마이그레이션 하나의 적용 상태입니다.
+type Status struct {
+	Version   int
+	Name      string
+	Applied   bool
+	AppliedAt string
+}
+
+const createVersionTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    version    INTEGER PRIMARY KEY,
+    name       TEXT NOT NULL,
+    applied_at TEXT NOT NULL
+)`
+
+// All 은 dialect 용으로 포함된 모든 마이그레이션을 버전 순으로 반환합니다.
+func All(dialect Dialect) ([]Migration, error) {
+	dir := string(dialect)
+	entries, err := fs.ReadDir(files, dir)
+	if err != nil {
+		return nil, fmt.Errorf("지원하지 않는 DB 종류입니다: %q", dialect)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(fileName, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(fileName, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+
+		base := strings.TrimSuffix(fileName, "."+direction+".sql")
+		versionPart, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("마이그레이션 파일 이름 형식 오류: %s", fileName)
+		}
+		version, err := strconv.Atoi(versionPart)
+		if err != nil {
+			return nil, fmt.Errorf("마이그레이션 버전 파싱 실패: %s", fileName)
+		}
+
+		body, err := files.ReadFile(path.Join(dir, fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		m, exists := byVersion[version]
+		if !exists {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("버전 %d 의 마이그레이션 이름이 다릅니다: %s / %s", version, m.Name, name)
+		}
+		if direction == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("버전 %d (%s) 에 up 파일이 없습니다", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Up 은 아직 적용되지 않은 마이그레이션을 모두 적용하고, 적용한 목록을 반환합니다.
+func Up(db *sql.DB, dialect Dialect) ([]Migration, error) {
+	all, applied, err := load(db, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, m := range all {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		err := inTx(db, func(tx *sql.Tx) error {
+			if _, err := tx.Exec(m.Up); err != nil {
+				return err
+			}
+			_, err := tx.Exec(dialect.Bind("INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)"),
+				m.Version, m.Name, time.Now().UTC().Format(time.RFC3339))
+			return err
+		})
+		if err != nil {
+			return done, fmt.Errorf("마이그레이션 %04d_%s 적용 실패: %w", m.Version, m.Name, err)
+		}
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// Down 은 가장 최근에 적용된 마이그레이션부터 steps 개를 되돌리고, 되돌린 목록을 반환합니다.
+func Down(db *sql.DB, dialect Dialect, steps int) ([]Migration, error) {
+	all, applied, err := load(db, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(all) - 1; i >= 0 && len(done) < steps; i-- {
+		m := all[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+		if m.Down == "" {
+			return done, fmt.Errorf("마이그레이션 %04d_%s 에 down 파일이 없어 되돌릴 수 없습니다", m.Version, m.Name)
+		}
+		err := inTx(db, func(tx *sql.Tx) error {
+			if _, err := tx.Exec(m.Down); err != nil {
+				return err
+			}
+			_, err := tx.Exec(dialect.Bind("DELETE FROM schema_migrations WHERE version = ?"), m.Version)
+			return err
+		})
+		if err != nil {
+			return done, fmt.Errorf("마이그레이션 %04d_%s 되돌리기 실패: %w", m.Version, m.Name, err)
+		}
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// StatusOf 는 모든 마이그레이션의 적용 상태를 버전 순으로 반환합니다.
+func StatusOf(db *sql.DB, dialect Dialect) ([]Status, error) {
+	all, applied, err := load(db, dialect)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, len(all))
+	for i, m := range all {
+		appliedAt, ok := applied[m.Version]
+		statuses[i] = Status{Version: m.Version, Name: m.Name, Applied: ok, AppliedAt: appliedAt}
+	}
+	return statuses, nil
+}
+
+// load 는 포함된 마이그레이션 목록과 이미 적용된 버전(버전 -> 적용 시각)을 읽습니다.
+func load(db *sql.DB, dialect Dialect) ([]Migration, map[int]string, error) {
+	all, err := All(dialect)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := db.Exec(createVersionTable); err != nil {
+		return nil, nil, fmt.Errorf("schema_migrations 테이블 생성 실패: %w", err)
+	}
+
+	rows, err := db.Query("SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]string)
+	for rows.Next() {
+		var version int
+		var appliedAt string
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return all, applied, rows.Err()
+}
+
+func inTx(db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
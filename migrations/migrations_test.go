@@ -0,0 +1,223 @@
+package migrations
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openMemoryDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	// 인메모리 DB는 연결마다 따로 생기므로 연결을 하나로 제한합니다.
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestAllDialectsHaveSameVersions(t *testing.T) {
+	sqlite, err := All(SQLite)
+	require.NoError(t, err)
+	postgres, err := All(Postgres)
+	require.NoError(t, err)
+	require.NotEmpty(t, sqlite)
+	require.Len(t, postgres, len(sqlite))
+
+	for i, m := range sqlite {
+		assert.Equal(t, i+1, m.Version, "버전은 1부터 빠짐없이 이어져야 합니다")
+		assert.Equal(t, m.Version, postgres[i].Version)
+		assert.Equal(t, m.Name, postgres[i].Name, "버전 %04d", m.Version)
+		assert.NotEmpty(t, m.Up, "%04d_%s.up.sql", m.Version, m.Name)
+		assert.NotEmpty(t, m.Down, "%04d_%s.down.sql", m.Version, m.Name)
+		assert.NotEmpty(t, postgres[i].Down, "postgres %04d_%s.down.sql", m.Version, m.Name)
+	}
+}
+
+func TestAllUnknownDialect(t *testing.T) {
+	_, err := All(Dialect("mysql"))
+	assert.Error(t, err)
+}
+
+func TestBind(t *testing.T) {
+	query := "SELECT * FROM t WHERE a = ? AND b = ?"
+	assert.Equal(t, query, SQLite.Bind(query))
+	assert.Equal(t, "SELECT * FROM t WHERE a = $1 AND b = $2", Postgres.Bind(query))
+}
+
+// 아래 test* 함수는 SQLite(여기)와 PostgreSQL(postgres_test.go)에서 같이 돌립니다.
+// newDB 는 마이그레이션을 적용하지 않은 빈 DB를 만듭니다.
+
+// userTables 는 schema_migrations 를 뺀 테이블 수를 반환합니다.
+func userTables(t *testing.T, db *sql.DB, dialect Dialect) int {
+	t.Helper()
+	query := "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name NOT IN ('schema_migrations', 'sqlite_sequence')"
+	if dialect == Postgres {
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name <> 'schema_migrations'"
+	}
+	var n int
+	require.NoError(t, db.QueryRow(query).Scan(&n))
+	return n
+}
+
+func TestUpStatusDown(t *testing.T) {
+	testUpStatusDown(t, SQLite, openMemoryDB)
+}
+
+func testUpStatusDown(t *testing.T, dialect Dialect, newDB func(t *testing.T) *sql.DB) {
+	db := newDB(t)
+	all, err := All(dialect)
+	require.NoError(t, err)
+
+	applied, err := Up(db, dialect)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(all))
+
+	// 두 번째 Up 은 적용할 것이 없습니다.
+	applied, err = Up(db, dialect)
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+
+	statuses, err := StatusOf(db, dialect)
+	require.NoError(t, err)
+	require.Len(t, statuses, len(all))
+	for _, s := range statuses {
+		assert.True(t, s.Applied, "%04d_%s", s.Version, s.Name)
+		assert.NotEmpty(t, s.AppliedAt)
+	}
+
+	// 가장 최근 마이그레이션 하나를 되돌립니다.
+	reverted, err := Down(db, dialect, 1)
+	require.NoError(t, err)
+	require.Len(t, reverted, 1)
+	assert.Equal(t, all[len(all)-1].Version, reverted[0].Version)
+
+	statuses, err = StatusOf(db, dialect)
+	require.NoError(t, err)
+	assert.False(t, statuses[len(statuses)-1].Applied)
+	assert.True(t, statuses[len(statuses)-2].Applied)
+}
+
+// 모든 마이그레이션을 되돌린 뒤 다시 적용할 수 있어야 합니다. (down 파일이 up 을 빠짐없이 되돌리는지 확인)
+func TestDownAllAndUpAgain(t *testing.T) {
+	testDownAllAndUpAgain(t, SQLite, openMemoryDB)
+}
+
+func testDownAllAndUpAgain(t *testing.T, dialect Dialect, newDB func(t *testing.T) *sql.DB) {
+	db := newDB(t)
+	all, err := All(dialect)
+	require.NoError(t, err)
+
+	_, err = Up(db, dialect)
+	require.NoError(t, err)
+
+	reverted, err := Down(db, dialect, len(all))
+	require.NoError(t, err)
+	assert.Len(t, reverted, len(all))
+
+	assert.Zero(t, userTables(t, db, dialect), "모두 되돌린 뒤 남은 테이블이 없어야 합니다")
+
+	applied, err := Up(db, dialect)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(all))
+}
+
+func TestUpStopsAtFailingMigration(t *testing.T) {
+	testUpStopsAtFailingMigration(t, SQLite, openMemoryDB)
+}
+
+func testUpStopsAtFailingMigration(t *testing.T, dialect Dialect, newDB func(t *testing.T) *sql.DB) {
+	db := newDB(t)
+	all, err := All(dialect)
+	require.NoError(t, err)
+	// 0001 이 만들 테이블을 호환되지 않는 형태로 미리 만들어 두면 universities 를 쓰는 마이그레이션이 실패합니다.
+	_, err = db.Exec("CREATE TABLE universities (id INTEGER PRIMARY KEY)")
+	require.NoError(t, err)
+
+	applied, err := Up(db, dialect)
+	require.Error(t, err)
+	assert.Less(t, len(applied), len(all))
+
+	// 실패한 마이그레이션과 그 뒤 마이그레이션은 기록되지 않습니다.
+	statuses, err := StatusOf(db, dialect)
+	require.NoError(t, err)
+	recorded := 0
+	for _, s := range statuses {
+		if s.Applied {
+			recorded++
+		}
+	}
+	assert.Equal(t, len(applied), recorded)
+}
+
+// 0008_fix_subject_catalog_codes 는 마이그레이션의 초기 과목 목록만 고치고, 사용자가 적재한 목록은 건드리지 않습니다.
+func TestSubjectCodesFixOnlyTouchesSeedCatalog(t *testing.T) {
+	testSubjectCodesFixOnlyTouchesSeedCatalog(t, SQLite, openMemoryDB)
+}
+
+func testSubjectCodesFixOnlyTouchesSeedCatalog(t *testing.T, dialect Dialect, newDB func(t *testing.T) *sql.DB) {
+	const fixVersion = 8
+	parentOf := func(t *testing.T, db *sql.DB, name string) string {
+		t.Helper()
+		var parent string
+		require.NoError(t, db.QueryRow(dialect.Bind(
+			"SELECT parent_code FROM subjects_master WHERE subject_type = 'naesin' AND curriculum_version = '2015' AND name = ?"), name).Scan(&parent))
+		return parent
+	}
+	countVersion := func(t *testing.T, db *sql.DB, version string) int {
+		t.Helper()
+		var n int
+		require.NoError(t, db.QueryRow(dialect.Bind("SELECT COUNT(*) FROM subject_catalog_versions WHERE version = ?"), version).Scan(&n))
+		return n
+	}
+	// downTo 는 fixVersion 부터 최근 마이그레이션까지를 되돌립니다.
+	downTo := func(t *testing.T, db *sql.DB) {
+		t.Helper()
+		all, err := All(dialect)
+		require.NoError(t, err)
+		_, err = Down(db, dialect, len(all)-fixVersion+1)
+		require.NoError(t, err)
+	}
+
+	t.Run("초기 목록", func(t *testing.T) {
+		db := newDB(t)
+		_, err := Up(db, dialect)
+		require.NoError(t, err)
+		assert.Equal(t, "CURR_CAREER_MATH_SELECT", parentOf(t, db, "실용 수학"))
+		assert.Equal(t, 1, countVersion(t, db, "2015-codes-fix"))
+
+		downTo(t, db)
+		assert.Equal(t, "CURR_COMMON_MATH_SELECT", parentOf(t, db, "실용 수학"))
+		assert.Zero(t, countVersion(t, db, "2015-codes-fix"))
+	})
+
+	t.Run("적재한 목록", func(t *testing.T) {
+		db := newDB(t)
+		_, err := Up(db, dialect)
+		require.NoError(t, err)
+		downTo(t, db)
+
+		// 0007 까지 적용된 DB에 사용자가 목록을 적재한 상태를 흉내 냅니다.
+		_, err = db.Exec("INSERT INTO subject_catalog_versions (version, note) VALUES ('my-catalog', '학교에서 받은 목록')")
+		require.NoError(t, err)
+		var curriculums int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM subject_curriculums").Scan(&curriculums))
+
+		_, err = Up(db, dialect)
+		require.NoError(t, err)
+		assert.Equal(t, "CURR_COMMON_MATH_SELECT", parentOf(t, db, "실용 수학"), "적재한 목록의 교과는 그대로입니다")
+		assert.Zero(t, countVersion(t, db, "2015-codes-fix"))
+		var after int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM subject_curriculums").Scan(&after))
+		assert.Equal(t, curriculums, after)
+
+		// 되돌릴 때도 적재한 목록은 그대로 둡니다.
+		_, err = db.Exec("UPDATE subjects_master SET parent_code = 'CURR_CAREER_MATH_SELECT' WHERE name = '실용 수학'")
+		require.NoError(t, err)
+		downTo(t, db)
+		assert.Equal(t, "CURR_CAREER_MATH_SELECT", parentOf(t, db, "실용 수학"))
+	})
+}
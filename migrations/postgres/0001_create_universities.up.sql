@@ -0,0 +1,7 @@
+-- 대학(캠퍼스) 기본 정보. 지도 마커와 학과별 위치 조회에 사용합니다.
+CREATE TABLE IF NOT EXISTS universities (
+    id        TEXT PRIMARY KEY, -- "대학명+캠퍼스명" (예: "가야대학교본교(제1캠퍼스)")
+    name      TEXT NOT NULL,
+    latitude  DOUBLE PRECISION,
+    longitude DOUBLE PRECISION
+);
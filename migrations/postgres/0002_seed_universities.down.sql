@@ -0,0 +1,2 @@
+-- 초기 데이터는 모두 이 마이그레이션에서 들어오므로 테이블을 비웁니다.
+DELETE FROM universities;
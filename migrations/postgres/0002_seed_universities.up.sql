@@ -0,0 +1,463 @@
+-- 대학(캠퍼스) 기본 정보 초기 데이터. 기존 data/universities.db 의 universities 테이블에서 추출했습니다.
+-- id 는 "대학명+캠퍼스명" 형태이며, 이미 있는 행은 건드리지 않습니다.
+INSERT INTO universities (id, name, latitude, longitude) VALUES
+    ('ICT폴리텍대학본교(제1캠퍼스)', 'ICT폴리텍대학', 37.39736081, 127.2482712),
+    ('KDB금융대학교본교(제1캠퍼스)', 'KDB금융대학교', 37.5271224, 126.920832),
+    ('가야대학교본교(제1캠퍼스)', '가야대학교', 35.26981628, 128.872321),
+    ('가야대학교본교(제2캠퍼스)', '가야대학교', 35.71889183, 128.2503558),
+    ('가천대학교본교(제1캠퍼스)', '가천대학교', 37.45123487, 127.1293943),
+    ('가천대학교본교(제2캠퍼스)', '가천대학교', 37.42246598, 126.6879792),
+    ('가톨릭관동대학교본교(제1캠퍼스)', '가톨릭관동대학교', 37.73732212, 128.8736816),
+    ('가톨릭꽃동네대학교본교(제1캠퍼스)', '가톨릭꽃동네대학교', 36.52198028, 127.4029761),
+    ('가톨릭대학교본교(제1캠퍼스)', '가톨릭대학교', 37.487485, 126.7985672),
+    ('가톨릭대학교본교(제2캠퍼스)', '가톨릭대학교', 37.50239362, 127.0058606),
+    ('가톨릭대학교본교(제3캠퍼스)', '가톨릭대학교', 37.58490098, 127.0047707),
+    ('가톨릭상지대학교본교(제1캠퍼스)', '가톨릭상지대학교', 36.5694171, 128.7371098),
+    ('감리교신학대학교본교(제1캠퍼스)', '감리교신학대학교', 37.56791676, 126.9618924),
+    ('강남대학교본교(제1캠퍼스)', '강남대학교', 37.27412539, 127.1320999),
+    ('강동대학교본교(제1캠퍼스)', '강동대학교', 37.14356386, 127.6473747),
+    ('강릉영동대학교본교(제1캠퍼스)', '강릉영동대학교', 37.74742431, 128.855013),
+    ('강서대학교본교(제1캠퍼스)', '강서대학교', 37.54866924, 126.8541348),
+    ('강원대학교본교(제1캠퍼스)', '강원대학교', 37.86799562, 127.7483798),
+    ('강원대학교본교(제2캠퍼스)', '강원대학교', 37.45080283, 129.1600546),
+    ('강원도립대학교본교(제1캠퍼스)', '강원도립대학교', 37.87663526, 128.8306217),
+    ('거제대학교본교(제1캠퍼스)', '거제대학교', 34.85002064, 128.7253879),
+    ('건국대학교본교(제1캠퍼스)', '건국대학교', 37.53918267, 127.0747119),
+    ('건국대학교분교(제1캠퍼스)', '건국대학교(글로컬)', 36.94878087, 127.9124302),
+    ('건양대학교본교(제1캠퍼스)', '건양대학교', 36.18215192, 127.1096874),
+    ('건양대학교본교(제2캠퍼스)', '건양대학교', 36.30778182, 127.3426468),
+    ('건양사이버대학교본교(제1캠퍼스)', '건양사이버대학교', 36.30778182, 127.3426468),
+    ('경기과학기술대학교본교(제1캠퍼스)', '경기과학기술대학교', 37.33879842, 126.7364152),
+    ('경기대학교본교(제1캠퍼스)', '경기대학교', 37.30024015, 127.0401091),
+    ('경기대학교본교(제2캠퍼스)', '경기대학교', 37.56519469, 126.9622242),
+    ('경남대학교본교(제1캠퍼스)', '경남대학교', 35.18432093, 128.5550964),
+    ('경남도립거창대학본교(제1캠퍼스)', '경남도립거창대학', 35.67320256, 127.9124187),
+    ('경남도립남해대학본교(제1캠퍼스)', '경남도립남해대학', 34.83698605, 127.8984538),
+    ('경남정보대학교본교(제1캠퍼스)', '경남정보대학교', 35.14645085, 129.0090707),
+    ('경남정보대학교본교(제2캠퍼스)', '경남정보대학교', 35.17304615, 129.127655),
+    ('경동대학교본교(제1캠퍼스)', '경동대학교', 38.25159495, 128.5564905),
+    ('경동대학교본교(제3캠퍼스)', '경동대학교', 37.26473242, 127.7923033),
+    ('경동대학교본교(제4캠퍼스)', '경동대학교', 37.80946173, 127.0705297),
+    ('경민대학교본교(제1캠퍼스)', '경민대학교', 37.74612431, 127.0251315),
+    ('경복대학교본교(제1캠퍼스)', '경복대학교', 37.73519395, 127.2105791),
+    ('경북과학대학교본교(제1캠퍼스)', '경북과학대학교', 35.98318752, 128.345669),
+    ('경북대학교본교(제1캠퍼스)', '경북대학교', 35.88909749, 128.6143223),
+    ('경북대학교본교(제2캠퍼스)', '경북대학교', 35.86778612, 128.6046094),
+    ('경북도립대학교본교(제1캠퍼스)', '경북도립대학교', 36.64371979, 128.454589),
+    ('경북보건대학교본교(제1캠퍼스)', '경북보건대학교', 36.13820946, 128.0837653),
+    ('경북전문대학교본교(제1캠퍼스)', '경북전문대학교', 36.80700113, 128.6161424),
+    ('경상국립대학교본교(제1캠퍼스)', '경상국립대학교', 35.15671413, 128.0980014),
+    ('경상국립대학교본교(제2캠퍼스)', '경상국립대학교', 34.83936928, 128.399537),
+    ('경상국립대학교본교(제3캠퍼스)', '경상국립대학교', 35.24083322, 128.6320638),
+    ('경성대학교본교(제1캠퍼스)', '경성대학교', 35.13848731, 129.1001031),
+    ('경운대학교본교(제1캠퍼스)', '경운대학교', 36.17169936, 128.4680712),
+    ('경인교육대학교본교(제1캠퍼스)', '경인교육대학교', 37.53670489, 126.7188421),
+    ('경인교육대학교본교(제2캠퍼스)', '경인교육대학교', 37.43556886, 126.9170667),
+    ('경인여자대학교본교(제1캠퍼스)', '경인여자대학교', 37.54843508, 126.7235059),
+    ('경일대학교본교(제1캠퍼스)', '경일대학교', 35.91106877, 128.8020452),
+    ('경희대학교본교(제1캠퍼스)', '경희대학교', 37.59394914, 127.054891),
+    ('경희대학교본교(제2캠퍼스)', '경희대학교', 37.23980015, 127.0811988),
+    ('경희사이버대학교본교(제1캠퍼스)', '경희사이버대학교', 37.59394914, 127.054891),
+    ('계명대학교본교(제1캠퍼스)', '계명대학교', 35.8559056, 128.484579),
+    ('계명대학교본교(제2캠퍼스)', '계명대학교', 35.85552317, 128.5793157),
+    ('계명문화대학교본교(제1캠퍼스)', '계명문화대학교', 35.85946203, 128.4863418),
+    ('계원예술대학교본교(제1캠퍼스)', '계원예술대학교', 37.37947065, 126.9818215),
+    ('고려대학교 세종캠퍼스분교(제1캠퍼스)', '고려대학교(세종)', 36.61169462, 127.2910027),
+    ('고려대학교본교(제1캠퍼스)', '고려대학교', 37.58870342, 127.0316983),
+    ('고려사이버대학교본교(제1캠퍼스)', '고려사이버대학교', 37.58694427, 126.987063),
+    ('고신대학교본교(제1캠퍼스)', '고신대학교', 35.07946942, 129.0621819),
+    ('고신대학교본교(제2캠퍼스)', '고신대학교', 35.08107807, 129.0145128),
+    ('공주교육대학교본교(제1캠퍼스)', '공주교육대학교', 36.44520385, 127.1196552),
+    ('광신대학교본교(제1캠퍼스)', '광신대학교', 35.21601865, 126.8848364),
+    ('광양보건대학교본교(제1캠퍼스)', '광양보건대학교', 34.95324129, 127.5688134),
+    ('광운대학교본교(제1캠퍼스)', '광운대학교', 37.61937913, 127.0582634),
+    ('광주가톨릭대학교본교(제1캠퍼스)', '광주가톨릭대학교', 35.01543518, 126.8638867),
+    ('광주과학기술원본교(제1캠퍼스)', '광주과학기술원', 35.22687915, 126.8429479),
+    ('광주교육대학교본교(제1캠퍼스)', '광주교육대학교', 35.16470695, 126.9257301),
+    ('광주대학교본교(제1캠퍼스)', '광주대학교', 35.10590365, 126.8961699),
+    ('광주보건대학교본교(제1캠퍼스)', '광주보건대학교', 35.19169546, 126.8461886),
+    ('광주여자대학교본교(제1캠퍼스)', '광주여자대학교', 35.16313639, 126.7964649),
+    ('구미대학교본교(제1캠퍼스)', '구미대학교', 36.15216623, 128.2890883),
+    ('국립강릉원주대학교본교(제1캠퍼스)', '국립강릉원주대학교', 37.76573318, 128.8702174),
+    ('국립강릉원주대학교본교(제2캠퍼스)', '국립강릉원주대학교', 37.30519985, 127.9221808),
+    ('국립공주대학교본교(제1캠퍼스)', '국립공주대학교', 36.46684843, 127.1386695),
+    ('국립공주대학교본교(제2캠퍼스)', '국립공주대학교', 36.85084041, 127.1510196),
+    ('국립공주대학교본교(제3캠퍼스)', '국립공주대학교', 36.6697682, 126.8594774),
+    ('국립군산대학교본교(제1캠퍼스)', '국립군산대학교', 35.95034895, 126.6803556),
+    ('국립금오공과대학교본교(제1캠퍼스)', '국립금오공과대학교', 36.14566015, 128.3881197),
+    ('국립목포대학교본교(제1캠퍼스)', '국립목포대학교', 34.91027165, 126.4400867),
+    ('국립목포해양대학교본교(제1캠퍼스)', '국립목포해양대학교', 34.79177492, 126.3668304),
+    ('국립부경대학교본교(제1캠퍼스)', '국립부경대학교', 35.13117803, 129.1050455),
+    ('국립순천대학교본교(제1캠퍼스)', '국립순천대학교', 34.96676128, 127.4809231),
+    ('국립안동대학교본교(제1캠퍼스)', '국립경국대학교', 36.5414385, 128.797564),
+    ('국립창원대학교본교(제1캠퍼스)', '국립창원대학교', 35.24595963, 128.6948169),
+    ('국립한국교통대학교본교(제1캠퍼스)', '국립한국교통대학교', 36.9688467, 127.8727694),
+    ('국립한국교통대학교본교(제2캠퍼스)', '국립한국교통대학교', 36.76855615, 127.6240475),
+    ('국립한국교통대학교본교(제3캠퍼스)', '국립한국교통대학교', 37.31580974, 126.9545618),
+    ('국립한국해양대학교본교(제1캠퍼스)', '국립한국해양대학교', 35.07440691, 129.0871313),
+    ('국립한밭대학교본교(제1캠퍼스)', '국립한밭대학교', 36.34526635, 127.305262),
+    ('국민대학교본교(제1캠퍼스)', '국민대학교', 37.61028784, 126.9985206),
+    ('국제대학교본교(제1캠퍼스)', '국제대학교', 37.06551111, 127.0802911),
+    ('국제사이버대학교본교(제1캠퍼스)', '국제사이버대학교', 37.26959375, 127.0282232),
+    ('국제예술대학교본교(제1캠퍼스)', '국제예술대학교', 37.51691937, 127.0310425),
+    ('군산간호대학교본교(제1캠퍼스)', '군산간호대학교', 35.97101934, 126.7601078),
+    ('군장대학교본교(제1캠퍼스)', '군장대학교', 36.00194603, 126.7849482),
+    ('극동대학교본교(제1캠퍼스)', '극동대학교', 37.12825493, 127.641871),
+    ('글로벌사이버대학교본교(제1캠퍼스)', '글로벌사이버대학교', 36.79388746, 127.1994105),
+    ('금강대학교본교(제1캠퍼스)', '금강대학교', 36.30682659, 127.189714),
+    ('기독간호대학교본교(제1캠퍼스)', '기독간호대학교', 35.13830785, 126.9158689),
+    ('김천대학교본교(제1캠퍼스)', '김천대학교', 36.13994481, 128.081715),
+    ('김포대학교본교(제1캠퍼스)', '김포대학교', 37.72998026, 126.5472919),
+    ('김해대학교본교(제1캠퍼스)', '김해대학교', 35.25414006, 128.913047),
+    ('나사렛대학교본교(제1캠퍼스)', '나사렛대학교', 36.79834753, 127.1194779),
+    ('나주대학교본교(제1캠퍼스)', '나주대학교', 35.00824677, 126.649143),
+    ('남부대학교본교(제1캠퍼스)', '남부대학교', 35.2070609, 126.8422582),
+    ('남서울대학교본교(제1캠퍼스)', '남서울대학교', 36.91091373, 127.1436195),
+    ('농협대학교본교(제1캠퍼스)', '농협대학교', 37.6595131, 126.8732287),
+    ('단국대학교본교(제1캠퍼스)', '단국대학교', 37.32119387, 127.1325359),
+    ('단국대학교본교(제2캠퍼스)', '단국대학교', 36.83595249, 127.1652134),
+    ('대경대학교본교(제1캠퍼스)', '대경대학교', 35.83445176, 128.821047),
+    ('대경대학교본교(제2캠퍼스)', '대경대학교', 37.74803678, 127.2389314),
+    ('대구가톨릭대학교본교(제1캠퍼스)', '대구가톨릭대학교', 35.90913047, 128.8075847),
+    ('대구가톨릭대학교본교(제2캠퍼스)', '대구가톨릭대학교', 35.84418911, 128.5658257),
+    ('대구가톨릭대학교본교(제3캠퍼스)', '대구가톨릭대학교', 35.86133616, 128.587515),
+    ('대구경북과학기술원본교(제1캠퍼스)', '대구경북과학기술원', 35.70563776, 128.4550107),
+    ('대구과학대학교본교(제1캠퍼스)', '대구과학대학교', 35.92922906, 128.5427985),
+    ('대구교육대학교본교(제1캠퍼스)', '대구교육대학교', 35.85143158, 128.5877392),
+    ('대구대학교본교(제1캠퍼스)', '대구대학교', 35.90453897, 128.8428133),
+    ('대구보건대학교본교(제1캠퍼스)', '대구보건대학교', 35.92679788, 128.5440311),
+    ('대구예술대학교본교(제1캠퍼스)', '대구예술대학교', 36.03141932, 128.5080914),
+    ('대구한의대학교본교(제1캠퍼스)', '대구한의대학교', 35.79401913, 128.7773494),
+    ('대덕대학교본교(제1캠퍼스)', '대덕대학교', 36.39084871, 127.3674533),
+    ('대동대학교본교(제1캠퍼스)', '대동대학교', 35.22913251, 129.0958647),
+    ('대림대학교본교(제1캠퍼스)', '대림대학교', 37.40329135, 126.9306842),
+    ('대신대학교본교(제1캠퍼스)', '대신대학교', 35.80394479, 128.7451373),
+    ('대원대학교본교(제1캠퍼스)', '대원대학교', 37.1757963, 128.1905289),
+    ('대전가톨릭대학교본교(제1캠퍼스)', '대전가톨릭대학교', 36.6545677, 127.1970103),
+    ('대전과학기술대학교본교(제1캠퍼스)', '대전과학기술대학교', 36.30178686, 127.3757667),
+    ('대전대학교본교(제1캠퍼스)', '대전대학교', 36.33669617, 127.4590019),
+    ('대전보건대학교본교(제1캠퍼스)', '대전보건대학교', 36.35050616, 127.4559042),
+    ('대전신학대학교본교(제1캠퍼스)', '대전신학대학교', 36.34954811, 127.4240159),
+    ('대진대학교본교(제1캠퍼스)', '대진대학교', 37.87381021, 127.1575541),
+    ('덕성여자대학교본교(제1캠퍼스)', '덕성여자대학교', 37.65247041, 127.0158349),
+    ('동강대학교본교(제1캠퍼스)', '동강대학교', 35.16943786, 126.9223461),
+    ('동국대학교본교(제1캠퍼스)', '동국대학교', 37.55893664, 126.9987376),
+    ('동국대학교분교(제1캠퍼스)', '동국대학교(WISE)', 35.86479528, 129.1910314),
+    ('동남보건대학교본교(제1캠퍼스)', '동남보건대학교', 37.29693844, 126.9870001),
+    ('동덕여자대학교본교(제1캠퍼스)', '동덕여자대학교', 37.60680996, 127.0412053),
+    ('동명대학교본교(제1캠퍼스)', '동명대학교', 35.12469043, 129.1013803),
+    ('동서대학교본교(제1캠퍼스)', '동서대학교', 35.14607865, 129.0070389),
+    ('동서대학교본교(제2캠퍼스)', '동서대학교', 35.17304615, 129.127655),
+    ('동서울대학교본교(제1캠퍼스)', '동서울대학교', 37.45828544, 127.1288444),
+    ('동신대학교본교(제1캠퍼스)', '동신대학교', 35.04962488, 126.7179925),
+    ('동아대학교본교(제1캠퍼스)', '동아대학교', 35.11880939, 128.968085),
+    ('동아대학교본교(제2캠퍼스)', '동아대학교', 35.12099471, 129.017549),
+    ('동아방송예술대학교본교(제1캠퍼스)', '동아방송예술대학교', 37.06237083, 127.3533373),
+    ('동아보건대학교본교(제1캠퍼스)', '동아보건대학교', 34.73035573, 126.5674321),
+    ('동양대학교본교(제1캠퍼스)', '동양대학교', 36.8858482, 128.529937),
+    ('동양대학교본교(제2캠퍼스)', '동양대학교', 37.93407816, 127.0550888),
+    ('동양미래대학교본교(제1캠퍼스)', '동양미래대학교', 37.49999579, 126.8681697),
+    ('동원과학기술대학교본교(제1캠퍼스)', '동원과학기술대학교', 35.34401753, 129.0663542),
+    ('동원대학교본교(제1캠퍼스)', '동원대학교', 37.32807999, 127.4014405),
+    ('동의과학대학교본교(제1캠퍼스)', '동의과학대학교', 35.16584983, 129.0722266),
+    ('동의대학교본교(제1캠퍼스)', '동의대학교', 35.14431845, 129.0363099),
+    ('두원공과대학교본교(제1캠퍼스)', '두원공과대학교', 37.06599814, 127.4237179),
+    ('두원공과대학교본교(제2캠퍼스)', '두원공과대학교', 37.81690141, 126.8007104),
+    ('디지털서울문화예술대학교본교(제1캠퍼스)', '디지털서울문화예술대학교', 37.58544374, 126.9438792),
+    ('루터대학교본교(제1캠퍼스)', '루터대학교', 37.26443438, 127.1133734),
+    ('마산대학교본교(제1캠퍼스)', '마산대학교', 35.25987313, 128.5036938),
+    ('명지대학교 인문캠퍼스본교(제2캠퍼스)', '명지대학교', 37.58037702, 126.9213485),
+    ('명지대학교 자연캠퍼스본교(제1캠퍼스)', '명지대학교', 37.22180726, 127.1901837),
+    ('명지전문대학본교(제1캠퍼스)', '명지전문대학', 37.58528319, 126.9256478),
+    ('목원대학교본교(제1캠퍼스)', '목원대학교', 36.32886613, 127.3374467),
+    ('목포가톨릭대학교본교(제1캠퍼스)', '목포가톨릭대학교', 34.82727668, 126.4198974),
+    ('목포과학대학교본교(제1캠퍼스)', '목포과학대학교', 34.80787958, 126.4098144),
+    ('문경대학교본교(제1캠퍼스)', '문경대학교', 36.65756234, 128.1741778),
+    ('배재대학교본교(제1캠퍼스)', '배재대학교', 36.32323828, 127.3660745),
+    ('배화여자대학교본교(제1캠퍼스)', '배화여자대학교', 37.57954401, 126.9672919),
+    ('백석대학교본교(제1캠퍼스)', '백석대학교', 36.83883721, 127.1825206),
+    ('백석문화대학교본교(제1캠퍼스)', '백석문화대학교', 36.83856851, 127.1830387),
+    ('백석예술대학교본교(제1캠퍼스)', '백석예술대학교', 37.48037139, 126.996897),
+    ('백제예술대학교본교(제1캠퍼스)', '백제예술대학교', 35.99625577, 127.1299945),
+    ('부산가톨릭대학교본교(제1캠퍼스)', '부산가톨릭대학교', 35.24467531, 129.096872),
+    ('부산경상대학교본교(제1캠퍼스)', '부산경상대학교', 35.18542933, 129.1009589),
+    ('부산과학기술대학교본교(제1캠퍼스)', '부산과학기술대학교', 35.19419451, 129.0053031),
+    ('부산교육대학교본교(제1캠퍼스)', '부산교육대학교', 35.19568453, 129.0770792),
+    ('부산대학교본교(제1캠퍼스)', '부산대학교', 35.2322428, 129.0838245),
+    ('부산대학교본교(제2캠퍼스)', '부산대학교', 35.4546515, 128.8072096),
+    ('부산대학교본교(제3캠퍼스)', '부산대학교', 35.32563294, 129.0014023),
+    ('부산디지털대학교본교(제1캠퍼스)', '부산디지털대학교', 35.14636181, 129.0096146),
+    ('부산보건대학교본교(제1캠퍼스)', '부산보건대학교', 35.10672375, 128.9972309),
+    ('부산여자대학교본교(제1캠퍼스)', '부산여자대학교', 35.16923652, 129.0720552),
+    ('부산예술대학교본교(제1캠퍼스)', '부산예술대학교', 35.14183161, 129.0839112),
+    ('부산외국어대학교본교(제1캠퍼스)', '부산외국어대학교', 35.26618949, 129.0805533),
+    ('부산장신대학교본교(제1캠퍼스)', '부산장신대학교', 35.25631088, 128.8617182),
+    ('부천대학교본교(제1캠퍼스)', '부천대학교', 37.48892917, 126.7792752),
+    ('삼육대학교본교(제1캠퍼스)', '삼육대학교', 37.64335737, 127.1088503),
+    ('삼육보건대학교본교(제1캠퍼스)', '삼육보건대학교', 37.58621194, 127.0635533),
+    ('상명대학교본교(제1캠퍼스)', '상명대학교', 37.60410891, 126.9551595),
+    ('상명대학교본교(제2캠퍼스)', '상명대학교', 36.8313072, 127.1809929),
+    ('상지대학교본교(제1캠퍼스)', '상지대학교', 37.36905194, 127.9299322),
+    ('서강대학교본교(제1캠퍼스)', '서강대학교', 37.55146496, 126.94298),
+    ('서경대학교본교(제1캠퍼스)', '서경대학교', 37.61541478, 127.0135658),
+    ('서영대학교본교(제1캠퍼스)', '서영대학교', 35.17499287, 126.868095),
+    ('서영대학교본교(제2캠퍼스)', '서영대학교', 37.82549339, 126.7740687),
+    ('서울과학기술대학교본교(제1캠퍼스)', '서울과학기술대학교', 37.63307893, 127.0767947),
+    ('서울교육대학교본교(제1캠퍼스)', '서울교육대학교', 37.49074049, 127.0154245),
+    ('서울기독대학교', '서울기독대학교', 37.6006125641836, 126.91261135905143),
+    ('서울대학교본교(제1캠퍼스)', '서울대학교', 37.46628727, 126.9481564),
+    ('서울대학교본교(제2캠퍼스)', '서울대학교', 37.5812861, 127.0011412),
+    ('서울디지털대학교본교(제1캠퍼스)', '서울디지털대학교', 37.5550005, 126.8540201),
+    ('서울사이버대학교본교(제1캠퍼스)', '서울사이버대학교', 37.62984392, 127.0271231),
+    ('서울시립대학교본교(제1캠퍼스)', '서울시립대학교', 37.58257758, 127.0599881),
+    ('서울신학대학교본교(제1캠퍼스)', '서울신학대학교', 37.47829579, 126.7899678),
+    ('서울여자간호대학교본교(제1캠퍼스)', '서울여자간호대학교', 37.59767767, 126.9475934),
+    ('서울여자대학교본교(제1캠퍼스)', '서울여자대학교', 37.62738159, 127.0916212),
+    ('서울예술대학교본교(제1캠퍼스)', '서울예술대학교', 37.33341349, 126.8363023),
+    ('서울장신대학교본교(제1캠퍼스)', '서울장신대학교', 37.40916409, 127.2455406),
+    ('서울한영대학교본교(제1캠퍼스)', '서울한영대학교', 37.49658107, 126.85125),
+    ('서원대학교본교(제1캠퍼스)', '서원대학교', 36.62597974, 127.4821176),
+    ('서일대학교본교(제1캠퍼스)', '서일대학교', 37.5860828, 127.0972033),
+    ('서정대학교본교(제1캠퍼스)', '서정대학교', 37.85646582, 127.0378035),
+    ('선린대학교본교(제1캠퍼스)', '선린대학교', 36.09079975, 129.333162),
+    ('선문대학교본교(제1캠퍼스)', '선문대학교', 36.80104045, 127.0757322),
+    ('성결대학교본교(제1캠퍼스)', '성결대학교', 37.37986572, 126.9288104),
+    ('성공회대학교본교(제1캠퍼스)', '성공회대학교', 37.4875236, 126.8261635),
+    ('성균관대학교본교(제1캠퍼스)', '성균관대학교', 37.58722841, 126.9931151),
+    ('성균관대학교본교(제2캠퍼스)', '성균관대학교', 37.295977, 126.974164),
+    ('성신여자대학교본교(제1캠퍼스)', '성신여자대학교', 37.59165248, 127.0221456),
+    ('성신여자대학교본교(제2캠퍼스)', '성신여자대학교', 37.632038, 127.0271242),
+    ('성운대학교본교(제1캠퍼스)', '성운대학교', 36.06420752, 128.7614088),
+    ('세경대학교본교(제1캠퍼스)', '세경대학교', 37.17284072, 128.4543028),
+    ('세계사이버대학본교(제1캠퍼스)', '세계사이버대학', 37.35789003, 127.1578622),
+    ('세명대학교본교(제1캠퍼스)', '세명대학교', 37.17384235, 128.1961585),
+    ('세종대학교본교(제1캠퍼스)', '세종대학교', 37.55160931, 127.0731836),
+    ('세종사이버대학교본교(제1캠퍼스)', '세종사이버대학교', 37.55372747, 127.0728868),
+    ('세한대학교본교(제1캠퍼스)', '세한대학교', 34.74537742, 126.483264),
+    ('세한대학교본교(제2캠퍼스)', '세한대학교', 36.87615693, 126.780659),
+    ('송곡대학교본교(제1캠퍼스)', '송곡대학교', 37.79205248, 127.6493956),
+    ('송원대학교본교(제1캠퍼스)', '송원대학교', 35.10818451, 126.8732664),
+    ('송호대학교본교(제1캠퍼스)', '송호대학교', 37.47355624, 128.0022573),
+    ('수성대학교본교(제1캠퍼스)', '수성대학교', 35.85525272, 128.6496113),
+    ('수원가톨릭대학교본교(제1캠퍼스)', '수원가톨릭대학교', 37.19581551, 126.933338),
+    ('수원과학대학교본교(제1캠퍼스)', '수원과학대학교', 37.19203289, 126.9839265),
+    ('수원대학교본교(제1캠퍼스)', '수원대학교', 37.21011748, 126.9794804),
+    ('수원여자대학교본교(제1캠퍼스)', '수원여자대학교', 37.25270544, 126.9633073),
+    ('숙명여자대학교본교(제1캠퍼스)', '숙명여자대학교', 37.54547408, 126.9650744),
+    ('순복음총회신학교본교(제1캠퍼스)', '순복음총회신학교', 36.90424239, 128.1678519),
+    ('순천제일대학교본교(제1캠퍼스)', '순천제일대학교', 34.93379696, 127.4851975),
+    ('순천향대학교본교(제1캠퍼스)', '순천향대학교', 36.76835151, 126.9289365),
+    ('순천향대학교본교(제2캠퍼스)', '순천향대학교', 36.80281962, 127.1381514),
+    ('숭실대학교본교(제1캠퍼스)', '숭실대학교', 37.49642897, 126.9551579),
+    ('숭실사이버대학교본교(제1캠퍼스)', '숭실사이버대학교', 37.5744755, 126.9887758),
+    ('숭의여자대학교본교(제1캠퍼스)', '숭의여자대학교', 37.55785307, 126.9874816),
+    ('신경주대학교본교(제1캠퍼스)', '신경주대학교', 35.82926585, 129.1632394),
+    ('신구대학교본교(제1캠퍼스)', '신구대학교', 37.44885139, 127.1676966),
+    ('신라대학교본교(제1캠퍼스)', '신라대학교', 35.1720525, 128.9977969),
+    ('신성대학교본교(제1캠퍼스)', '신성대학교', 36.85370948, 126.5937687),
+    ('신안산대학교본교(제1캠퍼스)', '신안산대학교', 37.30901679, 126.8054165),
+    ('신한대학교본교(제1캠퍼스)', '신한대학교', 37.9038706, 127.0378875),
+    ('신한대학교본교(제2캠퍼스)', '신한대학교', 37.71020105, 127.0462897),
+    ('아신대학교본교(제1캠퍼스)', '아신대학교', 37.51121707, 127.4289283),
+    ('아주대학교본교(제1캠퍼스)', '아주대학교', 37.28319217, 127.0441282),
+    ('아주자동차대학교본교(제1캠퍼스)', '아주자동차대학교', 36.40350777, 126.5795708),
+    ('안동과학대학교본교(제1캠퍼스)', '안동과학대학교', 36.59020519, 128.6528111),
+    ('안산대학교본교(제1캠퍼스)', '안산대학교', 37.30833048, 126.8772738),
+    ('안양대학교본교(제1캠퍼스)', '안양대학교', 37.39178644, 126.9204636),
+    ('안양대학교본교(제2캠퍼스)', '안양대학교', 37.71100332, 126.444711),
+    ('여주대학교본교(제1캠퍼스)', '여주대학교', 37.27109289, 127.6363531),
+    ('연성대학교본교(제1캠퍼스)', '연성대학교', 37.39747189, 126.9092045),
+    ('연세대학교 미래캠퍼스분교(제1캠퍼스)', '연세대학교(미래)', 37.27481787, 127.9043283),
+    ('연세대학교본교(제1캠퍼스)', '연세대학교', 37.56605084, 126.9439162),
+    ('연암공과대학교본교(제1캠퍼스)', '연암공과대학교', 35.16531237, 128.0980918),
+    ('연암대학교본교(제1캠퍼스)', '연암대학교', 36.94691089, 127.1557403),
+    ('영남대학교본교(제1캠퍼스)', '영남대학교', 35.83049163, 128.7521801),
+    ('영남대학교본교(제2캠퍼스)', '영남대학교', 35.8476702, 128.5842035),
+    ('영남신학대학교본교(제1캠퍼스)', '영남신학대학교', 35.88039637, 128.8180666),
+    ('영남외국어대학본교(제1캠퍼스)', '영남외국어대학', 35.7851049, 128.7328729),
+    ('영남이공대학교본교(제1캠퍼스)', '영남이공대학교', 35.8476702, 128.5842035),
+    ('영산대학교본교(제1캠퍼스)', '영산대학교', 35.22415433, 129.1590647),
+    ('영산대학교본교(제2캠퍼스)', '영산대학교', 35.42839332, 129.1446348),
+    ('영산선학대학교본교(제1캠퍼스)', '영산선학대학교', 35.32735233, 126.44134),
+    ('영진전문대학교본교(제1캠퍼스)', '영진전문대학교', 35.89564476, 128.620619),
+    ('예수대학교본교(제1캠퍼스)', '예수대학교', 35.81609361, 127.1347885),
+    ('예원예술대학교본교(제1캠퍼스)', '예원예술대학교', 35.64776672, 127.2714666),
+    ('예원예술대학교본교(제2캠퍼스)', '예원예술대학교', 37.84666296, 127.0371187),
+    ('오산대학교본교(제1캠퍼스)', '오산대학교', 37.15356663, 127.0632054),
+    ('용인대학교본교(제1캠퍼스)', '용인대학교', 37.22787888, 127.1707243),
+    ('용인예술과학대학교본교(제1캠퍼스)', '용인예술과학대학교', 37.22921533, 127.2181852),
+    ('우석대학교본교(제1캠퍼스)', '우석대학교', 35.91157645, 127.0678117),
+    ('우석대학교본교(제2캠퍼스)', '우석대학교', 36.84527035, 127.4369224),
+    ('우송대학교본교(제1캠퍼스)', '우송대학교', 36.33569355, 127.445733),
+    ('우송정보대학본교(제1캠퍼스)', '우송정보대학', 36.33702928, 127.4535859),
+    ('울산과학기술원본교(제1캠퍼스)', '울산과학기술원', 35.57730714, 129.1876189),
+    ('울산과학대학교본교(제1캠퍼스)', '울산과학대학교', 35.49476813, 129.416053),
+    ('울산과학대학교본교(제2캠퍼스)', '울산과학대학교', 35.54136797, 129.2552955),
+    ('울산대학교본교(제1캠퍼스)', '울산대학교', 35.54183712, 129.2574253),
+    ('웅지세무대학교본교(제1캠퍼스)', '웅지세무대학교', 37.81875478, 126.7357045),
+    ('원광대학교본교(제1캠퍼스)', '원광대학교', 35.97171388, 126.9602237),
+    ('원광보건대학교본교(제1캠퍼스)', '원광보건대학교', 35.97274673, 126.9561969),
+    ('위덕대학교본교(제1캠퍼스)', '위덕대학교', 36.01611112, 129.2825906),
+    ('유원대학교본교(제1캠퍼스)', '유원대학교', 36.19589454, 127.7990854),
+    ('유원대학교본교(제2캠퍼스)', '유원대학교', 36.85129679, 127.0619121),
+    ('유한대학교본교(제1캠퍼스)', '유한대학교', 37.48810942, 126.8219814),
+    ('을지대학교본교(제1캠퍼스)', '을지대학교', 36.33302221, 127.4055717),
+    ('을지대학교본교(제2캠퍼스)', '을지대학교', 37.46097891, 127.1651468),
+    ('을지대학교본교(제3캠퍼스)', '을지대학교', 37.75182904, 127.0506957),
+    ('이화여자대학교본교(제1캠퍼스)', '이화여자대학교', 37.56446452, 126.9502888),
+    ('이화여자대학교본교(제2캠퍼스)', '이화여자대학교', 37.55624614, 126.8361757),
+    ('인덕대학교본교(제1캠퍼스)', '인덕대학교', 37.63025145, 127.0544486),
+    ('인제대학교본교(제1캠퍼스)', '인제대학교', 35.25281009, 128.9005153),
+    ('인제대학교본교(제2캠퍼스)', '인제대학교', 35.14645348, 129.0205712),
+    ('인천가톨릭대학교본교(제1캠퍼스)', '인천가톨릭대학교', 37.65667342, 126.4500083),
+    ('인천가톨릭대학교본교(제2캠퍼스)', '인천가톨릭대학교', 37.37262385, 126.6635077),
+    ('인천대학교본교(제1캠퍼스)', '인천대학교', 37.37807422, 126.6325101),
+    ('인하공업전문대학본교(제1캠퍼스)', '인하공업전문대학', 37.45148189, 126.6513509),
+    ('인하대학교본교(제1캠퍼스)', '인하대학교', 37.45148189, 126.6513509),
+    ('장로회신학대학교본교(제1캠퍼스)', '장로회신학대학교', 37.54986363, 127.103315),
+    ('장안대학교본교(제1캠퍼스)', '장안대학교', 37.21328732, 126.9444821),
+    ('재능대학교본교(제1캠퍼스)', '재능대학교', 37.47554184, 126.650465),
+    ('재능대학교본교(제2캠퍼스)', '재능대학교', 37.36980356, 126.6645539),
+    ('전남과학대학교본교(제1캠퍼스)', '전남과학대학교', 35.27730782, 127.1328612),
+    ('전남대학교본교(제1캠퍼스)', '전남대학교', 35.17391754, 126.9112355),
+    ('전남대학교본교(제2캠퍼스)', '전남대학교', 34.77299572, 127.7005184),
+    ('전남도립대학교본교(제1캠퍼스)', '전남도립대학교', 35.32652548, 126.9925943),
+    ('전북과학대학교본교(제1캠퍼스)', '전북과학대학교', 35.54803646, 126.8613697),
+    ('전북대학교본교(제1캠퍼스)', '전북대학교', 35.84113827, 127.131589),
+    ('전북대학교본교(제2캠퍼스)', '전북대학교', 35.942456, 126.9599055),
+    ('전주교육대학교본교(제1캠퍼스)', '전주교육대학교', 35.80914532, 127.1537997),
+    ('전주기전대학본교(제1캠퍼스)', '전주기전대학', 35.81782362, 127.1359569),
+    ('전주대학교본교(제1캠퍼스)', '전주대학교', 35.8161584, 127.0888821),
+    ('전주비전대학교본교(제1캠퍼스)', '전주비전대학교', 35.81087599, 127.0909664),
+    ('정화예술대학교본교(제1캠퍼스)', '정화예술대학교', 37.55985621, 126.9847828),
+    ('정화예술대학교본교(제2캠퍼스)', '정화예술대학교', 37.58005985, 127.0046544),
+    ('제주관광대학교본교(제1캠퍼스)', '제주관광대학교', 33.44748023, 126.4343028),
+    ('제주국제대학교본교(제1캠퍼스)', '제주국제대학교', 33.44277325, 126.5698311),
+    ('제주대학교본교(제1캠퍼스)', '제주대학교', 33.4572918, 126.5643222),
+    ('제주한라대학교본교(제1캠퍼스)', '제주한라대학교', 33.47678976, 126.4741519),
+    ('조선간호대학교본교(제1캠퍼스)', '조선간호대학교', 35.13781109, 126.9287741),
+    ('조선대학교본교(제1캠퍼스)', '조선대학교', 35.14274102, 126.9347238),
+    ('조선이공대학교본교(제1캠퍼스)', '조선이공대학교', 35.13985688, 126.9316929),
+    ('중부대학교본교(제1캠퍼스)', '중부대학교', 36.19197144, 127.4487038),
+    ('중부대학교본교(제2캠퍼스)', '중부대학교', 37.71371746, 126.8893915),
+    ('중앙대학교본교(제1캠퍼스)', '중앙대학교', 37.50472672, 126.9538339),
+    ('중앙대학교본교(제2캠퍼스)', '중앙대학교', 37.0004292, 127.2287087),
+    ('중앙승가대학교본교(제1캠퍼스)', '중앙승가대학교', 37.60693504, 126.7088665),
+    ('중원대학교본교(제1캠퍼스)', '중원대학교', 36.81915515, 127.7984938),
+    ('진주교육대학교본교(제1캠퍼스)', '진주교육대학교', 35.18581082, 128.06793),
+    ('진주보건대학교본교(제1캠퍼스)', '진주보건대학교', 35.19805829, 128.0666581),
+    ('차의과학대학교본교(제1캠퍼스)', '차의과학대학교', 37.85601441, 127.1375494),
+    ('창신대학교본교(제1캠퍼스)', '창신대학교', 35.2446929, 128.5995961),
+    ('창원문성대학교본교(제1캠퍼스)', '창원문성대학교', 35.23309145, 128.6602118),
+    ('청강문화산업대학교본교(제1캠퍼스)', '청강문화산업대학교', 37.20645349, 127.3564962),
+    ('청암대학교본교(제1캠퍼스)', '청암대학교', 34.92858777, 127.4893142),
+    ('청운대학교본교(제1캠퍼스)', '청운대학교', 36.58233856, 126.6642731),
+    ('청운대학교본교(제2캠퍼스)', '청운대학교', 37.4717635, 126.6606444),
+    ('청주교육대학교본교(제1캠퍼스)', '청주교육대학교', 36.6154292, 127.4840095),
+    ('청주대학교본교(제1캠퍼스)', '청주대학교', 36.6508565, 127.4951991),
+    ('청주대학교본교(제2캠퍼스)', '청주대학교', 36.91441528, 127.5532493),
+    ('초당대학교본교(제1캠퍼스)', '초당대학교', 34.98004859, 126.4702639),
+    ('총신대학교본교(제1캠퍼스)', '총신대학교', 37.48792901, 126.9667631),
+    ('추계예술대학교본교(제1캠퍼스)', '추계예술대학교', 37.56317672, 126.9540593),
+    ('춘천교육대학교본교(제1캠퍼스)', '춘천교육대학교', 37.85948672, 127.7485147),
+    ('춘해보건대학교본교(제1캠퍼스)', '춘해보건대학교', 35.45833286, 129.1959329),
+    ('충남대학교본교(제1캠퍼스)', '충남대학교', 36.36832812, 127.3416621),
+    ('충남도립대학교본교(제1캠퍼스)', '충남도립대학교', 36.43644092, 126.8019865),
+    ('충북대학교본교(제1캠퍼스)', '충북대학교', 36.63004439, 127.4550768),
+    ('충북도립대학교본교(제1캠퍼스)', '충북도립대학교', 36.29999918, 127.5721386),
+    ('충북보건과학대학교본교(제1캠퍼스)', '충북보건과학대학교', 36.70350037, 127.5533575),
+    ('충청대학교본교(제1캠퍼스)', '충청대학교', 36.61873573, 127.3698187),
+    ('칼빈대학교본교(제1캠퍼스)', '칼빈대학교', 37.30514593, 127.1286864),
+    ('태재대학교본교(제1캠퍼스)', '태재대학교', 37.58359325, 126.9883354),
+    ('평택대학교본교(제1캠퍼스)', '평택대학교', 36.99463923, 127.1339685),
+    ('포스코기술대학본교(제1캠퍼스)', '포스코기술대학', 36.01905705, 129.3293336),
+    ('포스코기술대학본교(제2캠퍼스)', '포스코기술대학', 34.94245668, 127.7265646),
+    ('포항공과대학교본교(제1캠퍼스)', '포항공과대학교', 36.01058831, 129.3213012),
+    ('포항대학교본교(제1캠퍼스)', '포항대학교', 36.08639842, 129.4107327),
+    ('한경국립대학교본교(제1캠퍼스)', '한경국립대학교', 37.01018733, 127.2637671),
+    ('한경국립대학교본교(제2캠퍼스)', '한경국립대학교', 37.05160326, 127.0948832),
+    ('한국골프대학교본교(제1캠퍼스)', '한국골프대학교', 37.46715369, 128.0766756),
+    ('한국공학대학교본교(제1캠퍼스)', '한국공학대학교', 37.33973274, 126.7335394),
+    ('한국과학기술원본교(제1캠퍼스)', '한국과학기술원', 36.36837506, 127.3567714),
+    ('한국과학기술원본교(제2캠퍼스)', '한국과학기술원', 37.59503671, 127.0463228),
+    ('한국관광대학교본교(제1캠퍼스)', '한국관광대학교', 37.28352147, 127.3779241),
+    ('한국교원대학교본교', '한국교원대학교', 36.60992576973353, 127.35939407624926),
+    ('한국기술교육대학교본교(제1캠퍼스)', '한국기술교육대학교', 36.76510495, 127.2812004),
+    ('한국농수산대학교본교(제1캠퍼스)', '한국농수산대학교', 35.83038854, 127.0685752),
+    ('한국방송통신대학교본교(제1캠퍼스)', '한국방송통신대학교', 37.57919549, 127.0032909),
+    ('한국복지사이버대학본교(제1캠퍼스)', '한국복지사이버대학', 35.78480317, 128.729308),
+    ('한국성서대학교본교(제1캠퍼스)', '한국성서대학교', 37.64896177, 127.063751),
+    ('한국승강기대학교본교(제1캠퍼스)', '한국승강기대학교', 35.67475129, 127.8956349),
+    ('한국에너지공과대학교본교(제1캠퍼스)', '한국에너지공과대학교', 35.01062843, 126.8032939),
+    ('한국열린사이버대학교본교(제1캠퍼스)', '한국열린사이버대학교', 37.59833282, 127.0916241),
+    ('한국영상대학교본교(제1캠퍼스)', '한국영상대학교', 36.46241109, 127.2097438),
+    ('한국예술종합학교본교(제1캠퍼스)', '한국예술종합학교', 37.60634586, 127.0539312),
+    ('한국예술종합학교본교(제2캠퍼스)', '한국예술종합학교', 37.47791902, 127.01077),
+    ('한국외국어대학교본교(제1캠퍼스)', '한국외국어대학교', 37.59829668, 127.0579424),
+    ('한국외국어대학교본교(제2캠퍼스)', '한국외국어대학교', 37.33773882, 127.268589),
+    ('한국전통문화대학교본교(제1캠퍼스)', '한국전통문화대학교', 36.30948012, 126.8968841),
+    ('한국체육대학교본교(제1캠퍼스)', '한국체육대학교', 37.51987037, 127.1296605),
+    ('한국침례신학대학교본교(제1캠퍼스)', '한국침례신학대학교', 36.38470934, 127.3230445),
+    ('한국폴리텍 I 대학 성남캠퍼스본교(제1캠퍼스)', '한국폴리텍 I 대학 성남캠퍼스', 37.45887795, 127.1539911),
+    ('한국폴리텍 I 대학 제주캠퍼스본교(제1캠퍼스)', '한국폴리텍 I 대학 제주캠퍼스', 33.44875664, 126.5613103),
+    ('한국폴리텍 II 대학 남인천캠퍼스본교(제1캠퍼스)', '한국폴리텍 II 대학 남인천캠퍼스', 37.47264801, 126.6773336),
+    ('한국폴리텍 II 대학 화성캠퍼스본교(제1캠퍼스)', '한국폴리텍 II 대학 화성캠퍼스', 37.13150769, 126.8896629),
+    ('한국폴리텍 III 대학 강릉캠퍼스본교(제1캠퍼스)', '한국폴리텍 III 대학 강릉캠퍼스', 37.73682823, 128.8904185),
+    ('한국폴리텍 III 대학 원주캠퍼스본교(제1캠퍼스)', '한국폴리텍 III 대학 원주캠퍼스', 37.36262166, 127.9331421),
+    ('한국폴리텍 III 대학 춘천캠퍼스본교(제1캠퍼스)', '한국폴리텍 III 대학 춘천캠퍼스', 37.79934199, 127.7774387),
+    ('한국폴리텍 IV 대학 대전캠퍼스본교(제1캠퍼스)', '한국폴리텍 IV 대학 대전캠퍼스', 36.3510399, 127.4537574),
+    ('한국폴리텍 IV 대학 아산캠퍼스본교(제1캠퍼스)', '한국폴리텍 IV 대학 아산캠퍼스', 36.77205091, 126.9488454),
+    ('한국폴리텍 IV 대학 충남캠퍼스본교(제1캠퍼스)', '한국폴리텍 IV 대학 충남캠퍼스', 36.58261959, 126.6561652),
+    ('한국폴리텍 V 대학 순천캠퍼스본교(제1캠퍼스)', '한국폴리텍 V 대학 순천캠퍼스', 34.95297292, 127.5268292),
+    ('한국폴리텍 V 대학 전북캠퍼스본교(제1캠퍼스)', '한국폴리텍 V 대학 전북캠퍼스', 35.80965615, 126.9397298),
+    ('한국폴리텍 VII 대학 동부산캠퍼스본교(제1캠퍼스)', '한국폴리텍 VII 대학 동부산캠퍼스', 35.31129377, 129.1793182),
+    ('한국폴리텍 VII 대학 울산캠퍼스본교(제1캠퍼스)', '한국폴리텍 VII 대학 울산캠퍼스', 35.57853069, 129.3480368),
+    ('한국폴리텍 Ⅵ 대학 영주캠퍼스본교(제1캠퍼스)', '한국폴리텍 Ⅵ 대학 영주캠퍼스', 36.80074109, 128.602823),
+    ('한국폴리텍 특성화대학 로봇캠퍼스본교(제1캠퍼스)', '한국폴리텍 특성화대학 로봇캠퍼스', 35.97262229, 128.9224312),
+    ('한국폴리텍IV 대학 청주캠퍼스본교(제1캠퍼스)', '한국폴리텍IV 대학 청주캠퍼스', 36.6395868, 127.4483782),
+    ('한국폴리텍VII대학 창원캠퍼스본교(제1캠퍼스)', '한국폴리텍VII대학 창원캠퍼스', 35.22571157, 128.6693024),
+    ('한국폴리텍V대학 광주캠퍼스본교(제1캠퍼스)', '한국폴리텍V대학 광주캠퍼스', 35.18295614, 126.8786718),
+    ('한국폴리텍V대학 전남캠퍼스본교(제1캠퍼스)', '한국폴리텍V대학 전남캠퍼스', 34.92353635, 126.428927),
+    ('한국폴리텍Ⅴ대학 익산캠퍼스본교(제1캠퍼스)', '한국폴리텍Ⅴ대학 익산캠퍼스', 35.95199224, 126.9905814),
+    ('한국폴리텍Ⅶ대학 부산캠퍼스본교(제1캠퍼스)', '한국폴리텍Ⅶ대학 부산캠퍼스', 35.21847737, 129.0189807),
+    ('한국폴리텍대학 대구캠퍼스본교(제1캠퍼스)', '한국폴리텍대학 대구캠퍼스', 35.87414381, 128.5530151),
+    ('한국폴리텍대학 서울강서캠퍼스본교(제1캠퍼스)', '한국폴리텍대학 서울강서캠퍼스', 37.54924248, 126.8424451),
+    ('한국폴리텍대학 서울정수캠퍼스본교(제1캠퍼스)', '한국폴리텍대학 서울정수캠퍼스', 37.52997497, 126.996817),
+    ('한국폴리텍대학 영남융합기술캠퍼스본교(제1캠퍼스)', '한국폴리텍대학 영남융합기술캠퍼스', 35.92024304, 128.6420149),
+    ('한국폴리텍대학 인천캠퍼스본교(제1캠퍼스)', '한국폴리텍대학 인천캠퍼스', 37.47913854, 126.7542073),
+    ('한국폴리텍대학 항공캠퍼스본교(제1캠퍼스)', '한국폴리텍대학 항공캠퍼스', 34.94784952, 128.1079869),
+    ('한국폴리텍대학바이오캠퍼스본교(제1캠퍼스)', '한국폴리텍대학바이오캠퍼스', 36.14597867, 127.0214846),
+    ('한국폴리텍특성화대학 반도체융합캠퍼스본교(제1캠퍼스)', '한국폴리텍특성화대학 반도체융합캠퍼스', 37.00855627, 127.1762245),
+    ('한국항공대학교본교(제1캠퍼스)', '한국항공대학교', 37.59901633, 126.8641725),
+    ('한남대학교본교(제1캠퍼스)', '한남대학교', 36.35515113, 127.4224685),
+    ('한남대학교본교(제2캠퍼스)', '한남대학교', 36.39953732, 127.3907355),
+    ('한동대학교본교(제1캠퍼스)', '한동대학교', 36.10230143, 129.3892661),
+    ('한라대학교본교(제1캠퍼스)', '한라대학교', 37.30397683, 127.906919),
+    ('한림대학교본교(제1캠퍼스)', '한림대학교', 37.88542755, 127.7349621),
+    ('한림성심대학교본교(제1캠퍼스)', '한림성심대학교', 37.89808323, 127.7570533),
+    ('한서대학교본교(제1캠퍼스)', '한서대학교', 36.69071528, 126.5869648),
+    ('한서대학교본교(제2캠퍼스)', '한서대학교', 36.59459057, 126.2940623),
+    ('한성대학교본교(제1캠퍼스)', '한성대학교', 37.58323585, 127.01039),
+    ('한세대학교본교(제1캠퍼스)', '한세대학교', 37.34414604, 126.9538857),
+    ('한신대학교본교(제1캠퍼스)', '한신대학교', 37.19259432, 127.027452),
+    ('한양대학교본교(제1캠퍼스)', '한양대학교', 37.55450355, 127.0466112),
+    ('한양대학교분교(제1캠퍼스)', '한양대학교(ERICA)', 37.29801613, 126.8344205),
+    ('한양사이버대학교본교(제1캠퍼스)', '한양사이버대학교', 37.55718636, 127.047519),
+    ('한양여자대학교본교(제1캠퍼스)', '한양여자대학교', 37.55791759, 127.0493219),
+    ('한영대학교본교(제1캠퍼스)', '한영대학교', 34.75371367, 127.7148875),
+    ('한일장신대학교본교(제1캠퍼스)', '한일장신대학교', 35.76428669, 127.2058652),
+    ('현대중공업공과대학본교(제1캠퍼스)', '현대중공업공과대학', 35.50353987, 129.4345113),
+    ('협성대학교본교(제1캠퍼스)', '협성대학교', 37.21325088, 126.953623),
+    ('혜전대학교본교(제1캠퍼스)', '혜전대학교', 36.57978637, 126.659169),
+    ('호남대학교본교(제1캠퍼스)', '호남대학교', 35.14996766, 126.7650403),
+    ('호남신학대학교본교(제1캠퍼스)', '호남신학대학교', 35.14011029, 126.9105687),
+    ('호산대학교본교(제1캠퍼스)', '호산대학교', 35.90198632, 128.7987951),
+    ('호서대학교본교(제1캠퍼스)', '호서대학교', 36.73446302, 127.0771069),
+    ('호서대학교본교(제2캠퍼스)', '호서대학교', 36.82722816, 127.1837498),
+    ('호서대학교본교(제3캠퍼스)', '호서대학교', 37.00312848, 126.5840513),
+    ('호원대학교본교(제1캠퍼스)', '호원대학교', 35.96792618, 126.8637877),
+    ('홍익대학교 세종캠퍼스본교(제2캠퍼스)', '홍익대학교 세종캠퍼스', 36.61977533, 127.288098),
+    ('홍익대학교본교(제1캠퍼스)', '홍익대학교', 37.5525246, 126.9250355),
+    ('화성의과학대학교본교(제1캠퍼스)', '화성의과학대학교', 37.20275154, 126.8395342),
+    ('화신사이버대학교본교(제1캠퍼스)', '화신사이버대학교', 35.18574618, 129.1032576)
+ON CONFLICT (id) DO NOTHING;
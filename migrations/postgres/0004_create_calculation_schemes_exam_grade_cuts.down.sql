@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS exam_grade_cuts;
+DROP TABLE IF EXISTS calculation_schemes;
@@ -0,0 +1,20 @@
+-- 전형별 성적 산출 스키마. scheme_json 은 handlers.CalculationScheme 의 JSON 입니다.
+CREATE TABLE IF NOT EXISTS calculation_schemes (
+    id                   TEXT PRIMARY KEY,
+    admission_program_id TEXT NOT NULL, -- AdmissionProgramID
+    admission_type       TEXT NOT NULL,
+    scheme_json          TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_calculation_schemes_program ON calculation_schemes (admission_program_id);
+
+-- 시험별 등급컷. cut_data_json 은 원점수 구간별 표준점수/백분위/등급 배열입니다.
+CREATE TABLE IF NOT EXISTS exam_grade_cuts (
+    id            BIGSERIAL PRIMARY KEY,
+    exam_year     INTEGER NOT NULL,
+    exam_month    INTEGER NOT NULL,
+    exam_name     TEXT NOT NULL,
+    subject_area  TEXT NOT NULL, -- 예: "국어"
+    subject_name  TEXT NOT NULL, -- 예: "언어와 매체"
+    cut_data_json TEXT NOT NULL,
+    UNIQUE (exam_year, exam_month, subject_area, subject_name)
+);
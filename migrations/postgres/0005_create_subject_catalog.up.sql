@@ -0,0 +1,36 @@
+-- 과목 목록(교과구분종류 > 교과 > 과목, 수능 선택과목). GET /api/subjects 와 성적 입력 검증이 사용합니다.
+-- `univ import-subjects` 로 목록 전체를 교체하며, 교체할 때마다 subject_catalog_versions 에 버전을 남깁니다.
+CREATE TABLE IF NOT EXISTS subject_catalog_versions (
+    id          BIGSERIAL PRIMARY KEY,
+    version     TEXT NOT NULL UNIQUE,
+    note        TEXT NOT NULL DEFAULT '',
+    imported_at TEXT NOT NULL DEFAULT (to_char(now() AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'))
+);
+
+-- 교과구분종류 (예: 일반선택, 진로선택)
+CREATE TABLE IF NOT EXISTS subject_classifications (
+    code          TEXT PRIMARY KEY,
+    name          TEXT NOT NULL,
+    display_order INTEGER NOT NULL DEFAULT 0
+);
+
+-- 교과 (예: 국어, 수학). 같은 교과 코드가 여러 교과구분종류에 쓰일 수 있습니다.
+CREATE TABLE IF NOT EXISTS subject_curriculums (
+    classification_code TEXT NOT NULL REFERENCES subject_classifications (code) ON DELETE CASCADE,
+    code                TEXT NOT NULL,
+    name                TEXT NOT NULL,
+    display_order       INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (classification_code, code)
+);
+
+-- 과목. subject_type 이 'naesin' 이면 parent_code 는 교과 코드, 'suneung' 이면 영역(국어, 수학, 사회탐구, 과학탐구)입니다.
+CREATE TABLE IF NOT EXISTS subjects_master (
+    id            BIGSERIAL PRIMARY KEY,
+    subject_type  TEXT NOT NULL,
+    parent_code   TEXT NOT NULL,
+    code          TEXT NOT NULL,
+    name          TEXT NOT NULL,
+    display_order INTEGER NOT NULL DEFAULT 0,
+    UNIQUE (subject_type, parent_code, name)
+);
+CREATE INDEX IF NOT EXISTS idx_subjects_master_code ON subjects_master (code);
@@ -0,0 +1,163 @@
+-- 교육과정 버전(2009 / 2015 / 2022 개정)과 적용 대입 연도 범위를 과목 목록에 추가하고, 2022 개정 교육과정 과목을 넣습니다.
+-- curriculum_version 이 2022 인 내신 과목은 5등급제, 나머지는 9등급제입니다. (handlers/curriculum.go)
+ALTER TABLE subject_classifications ADD COLUMN curriculum_version TEXT NOT NULL DEFAULT '2015';
+ALTER TABLE subject_classifications ADD COLUMN admission_year_from INTEGER;
+ALTER TABLE subject_classifications ADD COLUMN admission_year_to INTEGER;
+ALTER TABLE subject_curriculums ADD COLUMN curriculum_version TEXT NOT NULL DEFAULT '2015';
+ALTER TABLE subject_curriculums ADD COLUMN admission_year_from INTEGER;
+ALTER TABLE subject_curriculums ADD COLUMN admission_year_to INTEGER;
+ALTER TABLE subjects_master ADD COLUMN curriculum_version TEXT NOT NULL DEFAULT '2015';
+ALTER TABLE subjects_master ADD COLUMN admission_year_from INTEGER;
+ALTER TABLE subjects_master ADD COLUMN admission_year_to INTEGER;
+CREATE INDEX IF NOT EXISTS idx_subjects_master_curriculum_version ON subjects_master (curriculum_version);
+
+-- 기존 목록은 2015 개정(2021~2027학년도 대입) 기준이며, 2009 개정 과목명이 섞여 있습니다.
+UPDATE subject_classifications SET admission_year_from = 2021, admission_year_to = 2027;
+UPDATE subject_curriculums SET admission_year_from = 2021, admission_year_to = 2027;
+UPDATE subjects_master SET admission_year_from = 2021, admission_year_to = 2027 WHERE subject_type = 'naesin';
+UPDATE subjects_master SET curriculum_version = '2009', admission_year_from = NULL, admission_year_to = 2020
+WHERE subject_type = 'naesin' AND name IN (
+    '국어Ⅰ',
+    '국어Ⅱ',
+    '독서와 문법',
+    '미적분Ⅰ',
+    '미적분Ⅱ',
+    '기하와 벡터',
+    '실용 영어Ⅰ',
+    '실용 영어Ⅱ',
+    '실용 영어 회화',
+    '실용 영어 독해와 작문',
+    '법과 정치',
+    '물리Ⅰ',
+    '물리Ⅱ'
+);
+-- 선택형 수능(2022~2027학년도)
+UPDATE subjects_master SET admission_year_from = 2022, admission_year_to = 2027 WHERE subject_type = 'suneung';
+
+INSERT INTO subject_catalog_versions (version, note) VALUES
+    ('2022-seed', '2022 개정 교육과정 과목 추가 (2028학년도 대입부터)')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO subject_classifications (code, name, display_order, curriculum_version, admission_year_from) VALUES
+    ('CLASS_2022_COMMON', '공통', 101, '2022', 2028),
+    ('CLASS_2022_GENERAL_SELECT', '일반선택', 102, '2022', 2028),
+    ('CLASS_2022_CAREER_SELECT', '진로선택', 103, '2022', 2028),
+    ('CLASS_2022_CONVERGENCE_SELECT', '융합선택', 104, '2022', 2028)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO subject_curriculums (classification_code, code, name, display_order, curriculum_version, admission_year_from) VALUES
+    ('CLASS_2022_COMMON', 'CURR_2022_COMMON_KOR', '국어', 101, '2022', 2028),
+    ('CLASS_2022_COMMON', 'CURR_2022_COMMON_MATH', '수학', 102, '2022', 2028),
+    ('CLASS_2022_COMMON', 'CURR_2022_COMMON_ENG', '영어', 103, '2022', 2028),
+    ('CLASS_2022_COMMON', 'CURR_2022_COMMON_SOCIETY', '사회(역사/도덕포함)', 104, '2022', 2028),
+    ('CLASS_2022_COMMON', 'CURR_2022_COMMON_SCIENCE', '과학', 105, '2022', 2028),
+    ('CLASS_2022_GENERAL_SELECT', 'CURR_2022_GENERAL_KOR', '국어', 106, '2022', 2028),
+    ('CLASS_2022_GENERAL_SELECT', 'CURR_2022_GENERAL_MATH', '수학', 107, '2022', 2028),
+    ('CLASS_2022_GENERAL_SELECT', 'CURR_2022_GENERAL_ENG', '영어', 108, '2022', 2028),
+    ('CLASS_2022_GENERAL_SELECT', 'CURR_2022_GENERAL_SOCIETY', '사회(역사/도덕포함)', 109, '2022', 2028),
+    ('CLASS_2022_GENERAL_SELECT', 'CURR_2022_GENERAL_SCIENCE', '과학', 110, '2022', 2028),
+    ('CLASS_2022_CAREER_SELECT', 'CURR_2022_CAREER_KOR', '국어', 111, '2022', 2028),
+    ('CLASS_2022_CAREER_SELECT', 'CURR_2022_CAREER_MATH', '수학', 112, '2022', 2028),
+    ('CLASS_2022_CAREER_SELECT', 'CURR_2022_CAREER_ENG', '영어', 113, '2022', 2028),
+    ('CLASS_2022_CAREER_SELECT', 'CURR_2022_CAREER_SOCIETY', '사회(역사/도덕포함)', 114, '2022', 2028),
+    ('CLASS_2022_CAREER_SELECT', 'CURR_2022_CAREER_SCIENCE', '과학', 115, '2022', 2028),
+    ('CLASS_2022_CONVERGENCE_SELECT', 'CURR_2022_CONVERGENCE_KOR', '국어', 116, '2022', 2028),
+    ('CLASS_2022_CONVERGENCE_SELECT', 'CURR_2022_CONVERGENCE_MATH', '수학', 117, '2022', 2028),
+    ('CLASS_2022_CONVERGENCE_SELECT', 'CURR_2022_CONVERGENCE_ENG', '영어', 118, '2022', 2028),
+    ('CLASS_2022_CONVERGENCE_SELECT', 'CURR_2022_CONVERGENCE_SOCIETY', '사회(역사/도덕포함)', 119, '2022', 2028),
+    ('CLASS_2022_CONVERGENCE_SELECT', 'CURR_2022_CONVERGENCE_SCIENCE', '과학', 120, '2022', 2028)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO subjects_master (subject_type, parent_code, code, name, display_order, curriculum_version, admission_year_from) VALUES
+    ('naesin', 'CURR_2022_COMMON_KOR', 'NAESIN_2022_공통국어1', '공통국어1', 2001, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_KOR', 'NAESIN_2022_공통국어2', '공통국어2', 2002, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_MATH', 'NAESIN_2022_공통수학1', '공통수학1', 2003, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_MATH', 'NAESIN_2022_공통수학2', '공통수학2', 2004, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_MATH', 'NAESIN_2022_기본수학1', '기본수학1', 2005, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_MATH', 'NAESIN_2022_기본수학2', '기본수학2', 2006, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_ENG', 'NAESIN_2022_공통영어1', '공통영어1', 2007, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_ENG', 'NAESIN_2022_공통영어2', '공통영어2', 2008, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_ENG', 'NAESIN_2022_기본영어1', '기본영어1', 2009, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_ENG', 'NAESIN_2022_기본영어2', '기본영어2', 2010, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_SOCIETY', 'NAESIN_2022_한국사1', '한국사1', 2011, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_SOCIETY', 'NAESIN_2022_한국사2', '한국사2', 2012, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_SOCIETY', 'NAESIN_2022_통합사회1', '통합사회1', 2013, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_SOCIETY', 'NAESIN_2022_통합사회2', '통합사회2', 2014, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_SCIENCE', 'NAESIN_2022_통합과학1', '통합과학1', 2015, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_SCIENCE', 'NAESIN_2022_통합과학2', '통합과학2', 2016, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_SCIENCE', 'NAESIN_2022_과학탐구실험1', '과학탐구실험1', 2017, '2022', 2028),
+    ('naesin', 'CURR_2022_COMMON_SCIENCE', 'NAESIN_2022_과학탐구실험2', '과학탐구실험2', 2018, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_KOR', 'NAESIN_2022_화법과_언어', '화법과 언어', 2019, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_KOR', 'NAESIN_2022_독서와_작문', '독서와 작문', 2020, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_KOR', 'NAESIN_2022_문학', '문학', 2021, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_MATH', 'NAESIN_2022_대수', '대수', 2022, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_MATH', 'NAESIN_2022_미적분Ⅰ', '미적분Ⅰ', 2023, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_MATH', 'NAESIN_2022_확률과_통계', '확률과 통계', 2024, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_ENG', 'NAESIN_2022_영어Ⅰ', '영어Ⅰ', 2025, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_ENG', 'NAESIN_2022_영어Ⅱ', '영어Ⅱ', 2026, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_ENG', 'NAESIN_2022_영어_독해와_작문', '영어 독해와 작문', 2027, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_SOCIETY', 'NAESIN_2022_세계시민과_지리', '세계시민과 지리', 2028, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_SOCIETY', 'NAESIN_2022_세계사', '세계사', 2029, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_SOCIETY', 'NAESIN_2022_사회와_문화', '사회와 문화', 2030, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_SOCIETY', 'NAESIN_2022_현대사회와_윤리', '현대사회와 윤리', 2031, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_SCIENCE', 'NAESIN_2022_물리학', '물리학', 2032, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_SCIENCE', 'NAESIN_2022_화학', '화학', 2033, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_SCIENCE', 'NAESIN_2022_생명과학', '생명과학', 2034, '2022', 2028),
+    ('naesin', 'CURR_2022_GENERAL_SCIENCE', 'NAESIN_2022_지구과학', '지구과학', 2035, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_KOR', 'NAESIN_2022_주제_탐구_독서', '주제 탐구 독서', 2036, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_KOR', 'NAESIN_2022_문학과_영상', '문학과 영상', 2037, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_KOR', 'NAESIN_2022_직무_의사소통', '직무 의사소통', 2038, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_MATH', 'NAESIN_2022_기하', '기하', 2039, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_MATH', 'NAESIN_2022_미적분Ⅱ', '미적분Ⅱ', 2040, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_MATH', 'NAESIN_2022_경제_수학', '경제 수학', 2041, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_MATH', 'NAESIN_2022_인공지능_수학', '인공지능 수학', 2042, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_MATH', 'NAESIN_2022_직무_수학', '직무 수학', 2043, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_ENG', 'NAESIN_2022_영미_문학_읽기', '영미 문학 읽기', 2044, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_ENG', 'NAESIN_2022_영어_발표와_토론', '영어 발표와 토론', 2045, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_ENG', 'NAESIN_2022_심화_영어', '심화 영어', 2046, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_ENG', 'NAESIN_2022_심화_영어_독해와_작문', '심화 영어 독해와 작문', 2047, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_ENG', 'NAESIN_2022_직무_영어', '직무 영어', 2048, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SOCIETY', 'NAESIN_2022_한국지리_탐구', '한국지리 탐구', 2049, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SOCIETY', 'NAESIN_2022_도시의_미래_탐구', '도시의 미래 탐구', 2050, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SOCIETY', 'NAESIN_2022_동아시아_역사_기행', '동아시아 역사 기행', 2051, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SOCIETY', 'NAESIN_2022_정치', '정치', 2052, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SOCIETY', 'NAESIN_2022_법과_사회', '법과 사회', 2053, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SOCIETY', 'NAESIN_2022_경제', '경제', 2054, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SOCIETY', 'NAESIN_2022_윤리와_사상', '윤리와 사상', 2055, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SOCIETY', 'NAESIN_2022_인문학과_윤리', '인문학과 윤리', 2056, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SOCIETY', 'NAESIN_2022_국제_관계의_이해', '국제 관계의 이해', 2057, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SCIENCE', 'NAESIN_2022_역학과_에너지', '역학과 에너지', 2058, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SCIENCE', 'NAESIN_2022_전자기와_양자', '전자기와 양자', 2059, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SCIENCE', 'NAESIN_2022_물질과_에너지', '물질과 에너지', 2060, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SCIENCE', 'NAESIN_2022_화학_반응의_세계', '화학 반응의 세계', 2061, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SCIENCE', 'NAESIN_2022_세포와_물질대사', '세포와 물질대사', 2062, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SCIENCE', 'NAESIN_2022_생물의_유전', '생물의 유전', 2063, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SCIENCE', 'NAESIN_2022_지구시스템과학', '지구시스템과학', 2064, '2022', 2028),
+    ('naesin', 'CURR_2022_CAREER_SCIENCE', 'NAESIN_2022_행성우주과학', '행성우주과학', 2065, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_KOR', 'NAESIN_2022_독서_토론과_글쓰기', '독서 토론과 글쓰기', 2066, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_KOR', 'NAESIN_2022_매체_의사소통', '매체 의사소통', 2067, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_KOR', 'NAESIN_2022_언어생활_탐구', '언어생활 탐구', 2068, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_MATH', 'NAESIN_2022_수학과_문화', '수학과 문화', 2069, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_MATH', 'NAESIN_2022_실용_통계', '실용 통계', 2070, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_MATH', 'NAESIN_2022_수학과제_탐구', '수학과제 탐구', 2071, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_ENG', 'NAESIN_2022_실생활_영어_회화', '실생활 영어 회화', 2072, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_ENG', 'NAESIN_2022_미디어_영어', '미디어 영어', 2073, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_ENG', 'NAESIN_2022_세계_문화와_영어', '세계 문화와 영어', 2074, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_SOCIETY', 'NAESIN_2022_여행지리', '여행지리', 2075, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_SOCIETY', 'NAESIN_2022_역사로_탐구하는_현대_세계', '역사로 탐구하는 현대 세계', 2076, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_SOCIETY', 'NAESIN_2022_사회문제_탐구', '사회문제 탐구', 2077, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_SOCIETY', 'NAESIN_2022_금융과_경제생활', '금융과 경제생활', 2078, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_SOCIETY', 'NAESIN_2022_윤리문제_탐구', '윤리문제 탐구', 2079, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_SOCIETY', 'NAESIN_2022_기후변화와_지속가능한_세계', '기후변화와 지속가능한 세계', 2080, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_SCIENCE', 'NAESIN_2022_과학의_역사와_문화', '과학의 역사와 문화', 2081, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_SCIENCE', 'NAESIN_2022_기후변화와_환경생태', '기후변화와 환경생태', 2082, '2022', 2028),
+    ('naesin', 'CURR_2022_CONVERGENCE_SCIENCE', 'NAESIN_2022_융합과학_탐구', '융합과학 탐구', 2083, '2022', 2028)
+ON CONFLICT DO NOTHING;
+
+-- 2022 개정 수능(2028학년도~)은 선택과목 없이 공통 과목만 봅니다.
+INSERT INTO subjects_master (subject_type, parent_code, code, name, display_order, curriculum_version, admission_year_from) VALUES
+    ('suneung', '국어', 'SUNEUNG_2022_국어', '국어', 2084, '2022', 2028),
+    ('suneung', '수학', 'SUNEUNG_2022_수학', '수학', 2085, '2022', 2028),
+    ('suneung', '사회탐구', 'SUNEUNG_2022_통합사회', '통합사회', 2086, '2022', 2028),
+    ('suneung', '과학탐구', 'SUNEUNG_2022_통합과학', '통합과학', 2087, '2022', 2028)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,121 @@
+-- 2015 개정 과목 목록의 코드 중복을 바로잡습니다. (handlers/subject_catalog_check.go 의 일관성 검사 참고)
+-- 이 정리는 마이그레이션이 넣은 초기 목록(2015-seed, 2022-seed)에만 적용합니다. `univ import-subjects` 로 적재한 목록이
+-- 있으면(subject_catalog_versions 에 다른 버전이 있으면) 아무것도 바꾸지 않습니다. 적재한 목록은 그 파일이 기준이기 때문입니다.
+-- 1) 같은 과목명이 여러 교과에 있어 과목 코드가 겹치던 것을, 먼저 나온 것만 남기고 나머지는 교과 이름을 붙여 구분합니다.
+--    예: 과학 교과의 과제 연구 NAESIN_과제_연구 → NAESIN_SCIENCE_과제_연구
+UPDATE subjects_master SET code = 'NAESIN_' || replace(replace(parent_code, 'CURR_COMMON_', ''), '_SELECT', '') || '_' || substr(code, 8)
+WHERE subject_type = 'naesin' AND curriculum_version <> '2022' AND EXISTS (
+    SELECT 1 FROM subjects_master o
+    WHERE o.subject_type = 'naesin' AND o.code = subjects_master.code AND o.display_order < subjects_master.display_order
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+
+-- 2) 진로선택 교과가 일반선택 교과 코드(CURR_COMMON_*)를 그대로 쓰고 있어 교과 코드가 겹쳤습니다.
+--    진로선택 교과는 CURR_CAREER_* 코드로 새로 만들고, 진로선택 과목이 없는 교과(전문교과 계열 등)는 뺍니다.
+--    기술·가정은 예전 목록에서 교과구분종류가 일반선택으로 잘못 적혀 빠져 있던 것을 넣습니다.
+DELETE FROM subject_curriculums WHERE classification_code = 'CLASS_CAREER_SELECT'
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+INSERT INTO subject_curriculums (classification_code, code, name, display_order, curriculum_version, admission_year_from, admission_year_to)
+SELECT * FROM (VALUES
+    ('CLASS_CAREER_SELECT', 'CURR_CAREER_KOR_SELECT', '국어', 37, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_CAREER_MATH_SELECT', '수학', 38, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_CAREER_ENG_SELECT', '영어', 39, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_CAREER_SOCIETY_SELECT', '사회(역사/도덕포함)', 40, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_CAREER_SCIENCE_SELECT', '과학', 41, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_CAREER_PE_SELECT', '체육', 42, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_CAREER_ART_SELECT', '예술', 43, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_CAREER_MECHANIC_HOUSE_SELECT', '기술·가정', 44, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_CAREER_SECOND_FOREIGN_LANGUAGE_SELECT', '제2외국어', 45, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_CAREER_CLASSICAL_CHINESE_SELECT', '한문', 46, '2015', 2021, 2027)
+) AS v WHERE NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'))
+ON CONFLICT DO NOTHING;
+
+-- 3) 진로선택 과목을 새 진로선택 교과로 옮깁니다. 과목 코드는 그대로입니다.
+UPDATE subjects_master SET parent_code = 'CURR_CAREER_KOR_SELECT'
+WHERE subject_type = 'naesin' AND parent_code = 'CURR_COMMON_KOR_SELECT' AND curriculum_version = '2015' AND name IN (
+    '실용 국어',
+    '심화국어',
+    '고전 읽기'
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+UPDATE subjects_master SET parent_code = 'CURR_CAREER_MATH_SELECT'
+WHERE subject_type = 'naesin' AND parent_code = 'CURR_COMMON_MATH_SELECT' AND curriculum_version = '2015' AND name IN (
+    '실용 수학',
+    '기하',
+    '경제 수학',
+    '수학과제 탐구',
+    '인공지능 수학'
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+UPDATE subjects_master SET parent_code = 'CURR_CAREER_ENG_SELECT'
+WHERE subject_type = 'naesin' AND parent_code = 'CURR_COMMON_ENG_SELECT' AND curriculum_version = '2015' AND name IN (
+    '실용 영어',
+    '영어권 문화',
+    '진로영어',
+    '영미문학읽기'
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+UPDATE subjects_master SET parent_code = 'CURR_CAREER_SOCIETY_SELECT'
+WHERE subject_type = 'naesin' AND parent_code = 'CURR_COMMON_SOCIETY_SELECT' AND curriculum_version = '2015' AND name IN (
+    '여행지리',
+    '사회문제 탐구',
+    '고전과 윤리'
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+UPDATE subjects_master SET parent_code = 'CURR_CAREER_SCIENCE_SELECT'
+WHERE subject_type = 'naesin' AND parent_code = 'CURR_COMMON_SCIENCE_SELECT' AND curriculum_version = '2015' AND name IN (
+    '물리학Ⅱ',
+    '화학Ⅱ',
+    '생명과학Ⅱ',
+    '지구과학Ⅱ',
+    '과학사',
+    '생활과 과학',
+    '융합과학'
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+UPDATE subjects_master SET parent_code = 'CURR_CAREER_PE_SELECT'
+WHERE subject_type = 'naesin' AND parent_code = 'CURR_COMMON_PE_SELECT' AND curriculum_version = '2015' AND name IN (
+    '스포츠 생활',
+    '체육 탐구'
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+UPDATE subjects_master SET parent_code = 'CURR_CAREER_ART_SELECT'
+WHERE subject_type = 'naesin' AND parent_code = 'CURR_COMMON_ART_SELECT' AND curriculum_version = '2015' AND name IN (
+    '음악 감상과 비평',
+    '미술 감상과 비평'
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+UPDATE subjects_master SET parent_code = 'CURR_CAREER_MECHANIC_HOUSE_SELECT'
+WHERE subject_type = 'naesin' AND parent_code = 'CURR_COMMON_MECHANIC_HOUSE_SELECT' AND curriculum_version = '2015' AND name IN (
+    '농업 생명 과학',
+    '공학 일반',
+    '창의 경영',
+    '해양 문화와 기술',
+    '가정과학',
+    '지식 재산 일반',
+    '인공지능 기초'
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+UPDATE subjects_master SET parent_code = 'CURR_CAREER_SECOND_FOREIGN_LANGUAGE_SELECT'
+WHERE subject_type = 'naesin' AND parent_code = 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT' AND curriculum_version = '2015' AND name IN (
+    '독일어Ⅱ',
+    '러시아어Ⅱ',
+    '베트남어Ⅱ',
+    '스페인어Ⅱ',
+    '아랍어Ⅱ',
+    '일본어Ⅱ',
+    '중국어Ⅱ',
+    '프랑스어Ⅱ'
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+UPDATE subjects_master SET parent_code = 'CURR_CAREER_CLASSICAL_CHINESE_SELECT'
+WHERE subject_type = 'naesin' AND parent_code = 'CURR_COMMON_CLASSICAL_CHINESE_SELECT' AND curriculum_version = '2015' AND name IN (
+    '한문Ⅱ'
+)
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'));
+
+INSERT INTO subject_catalog_versions (version, note)
+SELECT * FROM (VALUES
+    ('2015-codes-fix', '과목/교과 코드 중복 정리, 진로선택 교과 분리')
+) AS v WHERE NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed'))
+ON CONFLICT DO NOTHING;
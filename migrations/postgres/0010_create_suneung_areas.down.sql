@@ -0,0 +1,6 @@
+DELETE FROM subject_catalog_versions WHERE version = 'suneung-areas-seed';
+DELETE FROM subjects_master WHERE subject_type = 'suneung' AND parent_code IN ('영어', '한국사', '직업탐구', '제2외국어/한문');
+ALTER TABLE subjects_master DROP CONSTRAINT IF EXISTS subjects_master_subject_type_parent_code_name_version_key;
+ALTER TABLE subjects_master ADD CONSTRAINT subjects_master_subject_type_parent_code_name_key UNIQUE (subject_type, parent_code, name);
+DROP TABLE IF EXISTS suneung_selection_rules;
+DROP TABLE IF EXISTS suneung_areas;
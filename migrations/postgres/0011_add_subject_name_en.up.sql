@@ -0,0 +1,371 @@
+-- 과목 목록에 영어 이름(name_en)을 추가합니다. GET /api/subjects?lang=en (또는 Accept-Language: en)이면 이 이름을 보여 주고,
+-- 비어 있으면 한국어 이름을 그대로 씁니다. 코드는 언어와 관계없이 같습니다. (handlers/subject_lang.go)
+-- 전문교과(공업, 경영·금융 등 계열 과목)는 영어 이름을 넣지 않았으므로 import-subjects 로 채웁니다.
+
+ALTER TABLE subject_classifications ADD COLUMN name_en TEXT NOT NULL DEFAULT '';
+ALTER TABLE subject_curriculums ADD COLUMN name_en TEXT NOT NULL DEFAULT '';
+ALTER TABLE subjects_master ADD COLUMN name_en TEXT NOT NULL DEFAULT '';
+ALTER TABLE suneung_areas ADD COLUMN name_en TEXT NOT NULL DEFAULT '';
+ALTER TABLE suneung_selection_rules ADD COLUMN name_en TEXT NOT NULL DEFAULT '';
+
+-- 교과구분종류
+UPDATE subject_classifications SET name_en = 'General Elective' WHERE name = '일반선택';
+UPDATE subject_classifications SET name_en = 'Career Elective' WHERE name = '진로선택';
+UPDATE subject_classifications SET name_en = 'Integrated Science Experiment' WHERE name = '과학탐구실험';
+UPDATE subject_classifications SET name_en = 'Common' WHERE name = '공통';
+UPDATE subject_classifications SET name_en = 'Convergence Elective' WHERE name = '융합선택';
+
+-- 교과
+UPDATE subject_curriculums SET name_en = 'Korean Language' WHERE name = '국어';
+UPDATE subject_curriculums SET name_en = 'Mathematics' WHERE name = '수학';
+UPDATE subject_curriculums SET name_en = 'English' WHERE name = '영어';
+UPDATE subject_curriculums SET name_en = 'Social Studies (incl. History/Ethics)' WHERE name = '사회(역사/도덕포함)';
+UPDATE subject_curriculums SET name_en = 'Science' WHERE name = '과학';
+UPDATE subject_curriculums SET name_en = 'Physical Education' WHERE name = '체육';
+UPDATE subject_curriculums SET name_en = 'Arts' WHERE name = '예술';
+UPDATE subject_curriculums SET name_en = 'Industry' WHERE name = '공업';
+UPDATE subject_curriculums SET name_en = 'Fisheries & Maritime' WHERE name = '수산·해운';
+UPDATE subject_curriculums SET name_en = 'Design & Cultural Contents' WHERE name = '디자인·문화콘텐츠';
+UPDATE subject_curriculums SET name_en = 'Beauty, Tourism & Leisure' WHERE name = '미용·관광·레저';
+UPDATE subject_curriculums SET name_en = 'Food Processing' WHERE name = '식품·가공';
+UPDATE subject_curriculums SET name_en = 'Electrical & Electronics' WHERE name = '전기·전자';
+UPDATE subject_curriculums SET name_en = 'Construction' WHERE name = '건설';
+UPDATE subject_curriculums SET name_en = 'Business & Finance' WHERE name = '경영·금융';
+UPDATE subject_curriculums SET name_en = 'Science Track' WHERE name = '과학 계열';
+UPDATE subject_curriculums SET name_en = 'Liberal Arts' WHERE name = '교양';
+UPDATE subject_curriculums SET name_en = 'International Track' WHERE name = '국제 계열';
+UPDATE subject_curriculums SET name_en = 'Mechanical Engineering' WHERE name = '기계';
+UPDATE subject_curriculums SET name_en = 'Technology & Home Economics' WHERE name = '기술·가정';
+UPDATE subject_curriculums SET name_en = 'Agriculture, Forestry & Fisheries' WHERE name = '농림·수산해양';
+UPDATE subject_curriculums SET name_en = 'Health & Welfare' WHERE name = '보건·복지';
+UPDATE subject_curriculums SET name_en = 'Ship Operation' WHERE name = '선박 운항';
+UPDATE subject_curriculums SET name_en = 'Textiles & Clothing' WHERE name = '섬유·의류';
+UPDATE subject_curriculums SET name_en = 'Arts Track' WHERE name = '예술 계열';
+UPDATE subject_curriculums SET name_en = 'Foreign Language Track' WHERE name = '외국어 계열';
+UPDATE subject_curriculums SET name_en = 'Culinary Arts' WHERE name = '음식 조리';
+UPDATE subject_curriculums SET name_en = 'Printing, Publishing & Crafts' WHERE name = '인쇄·출판·공예';
+UPDATE subject_curriculums SET name_en = 'Materials' WHERE name = '재료';
+UPDATE subject_curriculums SET name_en = 'Information & Communications' WHERE name = '정보·통신';
+UPDATE subject_curriculums SET name_en = 'Second Foreign Language' WHERE name = '제2외국어';
+UPDATE subject_curriculums SET name_en = 'Physical Education Track' WHERE name = '체육 계열';
+UPDATE subject_curriculums SET name_en = 'Korean History' WHERE name = '한국사';
+UPDATE subject_curriculums SET name_en = 'Classical Chinese' WHERE name = '한문';
+UPDATE subject_curriculums SET name_en = 'Chemical Industry' WHERE name = '화학공업';
+UPDATE subject_curriculums SET name_en = 'Environment & Safety' WHERE name = '환경·안전';
+
+-- 수능 영역
+UPDATE suneung_areas SET name_en = 'Korean' WHERE name = '국어';
+UPDATE suneung_areas SET name_en = 'Mathematics' WHERE name = '수학';
+UPDATE suneung_areas SET name_en = 'English' WHERE name = '영어';
+UPDATE suneung_areas SET name_en = 'Korean History' WHERE name = '한국사';
+UPDATE suneung_areas SET name_en = 'Social Studies Inquiry' WHERE name = '사회탐구';
+UPDATE suneung_areas SET name_en = 'Science Inquiry' WHERE name = '과학탐구';
+UPDATE suneung_areas SET name_en = 'Vocational Inquiry' WHERE name = '직업탐구';
+UPDATE suneung_areas SET name_en = 'Second Foreign Language/Classical Chinese' WHERE name = '제2외국어/한문';
+
+-- 수능 선택 규칙 (2015/2022 개정 규칙은 코드 뒤쪽이 같습니다)
+UPDATE suneung_selection_rules SET name_en = 'Up to 2 inquiry subjects' WHERE code IN ('SUNEUNG_RULE_INQUIRY_MAX_2', 'SUNEUNG_RULE_2022_INQUIRY_MAX_2');
+UPDATE suneung_selection_rules SET name_en = 'Vocational and Social Studies inquiry cannot be combined' WHERE code IN ('SUNEUNG_RULE_VOCATIONAL_SOCIAL', 'SUNEUNG_RULE_2022_VOCATIONAL_SOCIAL');
+UPDATE suneung_selection_rules SET name_en = 'Vocational and Science inquiry cannot be combined' WHERE code IN ('SUNEUNG_RULE_VOCATIONAL_SCIENCE', 'SUNEUNG_RULE_2022_VOCATIONAL_SCIENCE');
+UPDATE suneung_selection_rules SET name_en = 'Physics I and Physics II cannot be taken together' WHERE code IN ('SUNEUNG_RULE_PHYSICS_1_2', 'SUNEUNG_RULE_2022_PHYSICS_1_2');
+UPDATE suneung_selection_rules SET name_en = 'Chemistry I and Chemistry II cannot be taken together' WHERE code IN ('SUNEUNG_RULE_CHEMISTRY_1_2', 'SUNEUNG_RULE_2022_CHEMISTRY_1_2');
+UPDATE suneung_selection_rules SET name_en = 'Life Science I and Life Science II cannot be taken together' WHERE code IN ('SUNEUNG_RULE_LIFE_SCIENCE_1_2', 'SUNEUNG_RULE_2022_LIFE_SCIENCE_1_2');
+UPDATE suneung_selection_rules SET name_en = 'Earth Science I and Earth Science II cannot be taken together' WHERE code IN ('SUNEUNG_RULE_EARTH_SCIENCE_1_2', 'SUNEUNG_RULE_2022_EARTH_SCIENCE_1_2');
+UPDATE suneung_selection_rules SET name_en = 'Up to 1 Second Foreign Language/Classical Chinese subject' WHERE code IN ('SUNEUNG_RULE_SECOND_FOREIGN_LANGUAGE_MAX_1', 'SUNEUNG_RULE_2022_SECOND_FOREIGN_LANGUAGE_MAX_1');
+
+-- 과목 (내신, 수능 공통. 같은 이름이면 교육과정 버전과 관계없이 같은 영어 이름)
+UPDATE subjects_master SET name_en = 'Home Science' WHERE name = '가정과학';
+UPDATE subjects_master SET name_en = 'General Engineering' WHERE name = '공학 일반';
+UPDATE subjects_master SET name_en = 'Technology & Home Economics' WHERE name = '기술·가정';
+UPDATE subjects_master SET name_en = 'Agricultural Life Science' WHERE name = '농업 생명 과학';
+UPDATE subjects_master SET name_en = 'Informatics' WHERE name = '정보';
+UPDATE subjects_master SET name_en = 'Intellectual Property' WHERE name = '지식 재산 일반';
+UPDATE subjects_master SET name_en = 'Creative Management' WHERE name = '창의 경영';
+UPDATE subjects_master SET name_en = 'Marine Culture and Technology' WHERE name = '해양 문화와 기술';
+UPDATE subjects_master SET name_en = 'Fundamentals of Artificial Intelligence' WHERE name = '인공지능 기초';
+UPDATE subjects_master SET name_en = 'Integrated Science Experiment' WHERE name = '과학탐구실험';
+UPDATE subjects_master SET name_en = 'Korean Language I' WHERE name = '국어Ⅰ';
+UPDATE subjects_master SET name_en = 'Korean Language II' WHERE name = '국어Ⅱ';
+UPDATE subjects_master SET name_en = 'Speech and Writing' WHERE name = '화법과 작문';
+UPDATE subjects_master SET name_en = 'Reading and Grammar' WHERE name = '독서와 문법';
+UPDATE subjects_master SET name_en = 'Literature' WHERE name = '문학';
+UPDATE subjects_master SET name_en = 'Korean Classics' WHERE name = '고전';
+UPDATE subjects_master SET name_en = 'Korean Language' WHERE name = '국어';
+UPDATE subjects_master SET name_en = 'Appreciation of Modern Literature' WHERE name = '현대문학감상';
+UPDATE subjects_master SET name_en = 'Reading' WHERE name = '독서';
+UPDATE subjects_master SET name_en = 'Practical Korean' WHERE name = '실용 국어';
+UPDATE subjects_master SET name_en = 'Language and Media' WHERE name = '언어와매체';
+UPDATE subjects_master SET name_en = 'Language and Media' WHERE name = '언어와 매체';
+UPDATE subjects_master SET name_en = 'Advanced Korean' WHERE name = '심화국어';
+UPDATE subjects_master SET name_en = 'Reading Classics' WHERE name = '고전 읽기';
+UPDATE subjects_master SET name_en = 'Basic Mathematics' WHERE name = '기초 수학';
+UPDATE subjects_master SET name_en = 'Mathematics I' WHERE name = '수학Ⅰ';
+UPDATE subjects_master SET name_en = 'Mathematics II' WHERE name = '수학Ⅱ';
+UPDATE subjects_master SET name_en = 'Probability and Statistics' WHERE name = '확률과 통계';
+UPDATE subjects_master SET name_en = 'Calculus I' WHERE name = '미적분Ⅰ';
+UPDATE subjects_master SET name_en = 'Calculus II' WHERE name = '미적분Ⅱ';
+UPDATE subjects_master SET name_en = 'Geometry and Vectors' WHERE name = '기하와 벡터';
+UPDATE subjects_master SET name_en = 'Advanced Mathematics I' WHERE name = '고급 수학Ⅰ';
+UPDATE subjects_master SET name_en = 'Advanced Mathematics II' WHERE name = '고급 수학Ⅱ';
+UPDATE subjects_master SET name_en = 'Mathematics' WHERE name = '수학';
+UPDATE subjects_master SET name_en = 'Calculus' WHERE name = '미적분';
+UPDATE subjects_master SET name_en = 'Mathematics Research Project' WHERE name = '수학과제 탐구';
+UPDATE subjects_master SET name_en = 'Economic Mathematics' WHERE name = '경제 수학';
+UPDATE subjects_master SET name_en = 'Geometry' WHERE name = '기하';
+UPDATE subjects_master SET name_en = 'Practical Mathematics' WHERE name = '실용 수학';
+UPDATE subjects_master SET name_en = 'Fundamental Mathematics' WHERE name = '기본 수학';
+UPDATE subjects_master SET name_en = 'Mathematics for Artificial Intelligence' WHERE name = '인공지능 수학';
+UPDATE subjects_master SET name_en = 'Basic English' WHERE name = '기초 영어';
+UPDATE subjects_master SET name_en = 'Practical English I' WHERE name = '실용 영어Ⅰ';
+UPDATE subjects_master SET name_en = 'Practical English II' WHERE name = '실용 영어Ⅱ';
+UPDATE subjects_master SET name_en = 'Practical English Conversation' WHERE name = '실용 영어 회화';
+UPDATE subjects_master SET name_en = 'Practical English Reading and Writing' WHERE name = '실용 영어 독해와 작문';
+UPDATE subjects_master SET name_en = 'English I' WHERE name = '영어Ⅰ';
+UPDATE subjects_master SET name_en = 'English II' WHERE name = '영어Ⅱ';
+UPDATE subjects_master SET name_en = 'English Conversation' WHERE name = '영어 회화';
+UPDATE subjects_master SET name_en = 'English Reading and Writing' WHERE name = '영어 독해와 작문';
+UPDATE subjects_master SET name_en = 'Advanced English' WHERE name = '심화 영어';
+UPDATE subjects_master SET name_en = 'Advanced English Conversation I' WHERE name = '심화 영어 회화Ⅰ';
+UPDATE subjects_master SET name_en = 'Advanced English Conversation II' WHERE name = '심화 영어 회화Ⅱ';
+UPDATE subjects_master SET name_en = 'Advanced English Reading I' WHERE name = '심화 영어 독해Ⅰ';
+UPDATE subjects_master SET name_en = 'Advanced English Reading II' WHERE name = '심화 영어 독해Ⅱ';
+UPDATE subjects_master SET name_en = 'Advanced English Writing' WHERE name = '심화 영어 작문';
+UPDATE subjects_master SET name_en = 'English' WHERE name = '영어';
+UPDATE subjects_master SET name_en = 'Practical English' WHERE name = '실용 영어';
+UPDATE subjects_master SET name_en = 'Reading English Literature' WHERE name = '영미문학읽기';
+UPDATE subjects_master SET name_en = 'Career English' WHERE name = '진로영어';
+UPDATE subjects_master SET name_en = 'Culture of English-speaking Countries' WHERE name = '영어권 문화';
+UPDATE subjects_master SET name_en = 'Fundamental English' WHERE name = '기본 영어';
+UPDATE subjects_master SET name_en = 'Korean Geography' WHERE name = '한국지리';
+UPDATE subjects_master SET name_en = 'World Geography' WHERE name = '세계지리';
+UPDATE subjects_master SET name_en = 'Korean History' WHERE name = '한국사';
+UPDATE subjects_master SET name_en = 'East Asian History' WHERE name = '동아시아사';
+UPDATE subjects_master SET name_en = 'World History' WHERE name = '세계사';
+UPDATE subjects_master SET name_en = 'Economics' WHERE name = '경제';
+UPDATE subjects_master SET name_en = 'Law and Politics' WHERE name = '법과 정치';
+UPDATE subjects_master SET name_en = 'Society and Culture' WHERE name = '사회·문화';
+UPDATE subjects_master SET name_en = 'International Politics' WHERE name = '국제 정치';
+UPDATE subjects_master SET name_en = 'International Economics' WHERE name = '국제 경제';
+UPDATE subjects_master SET name_en = 'International Relations and Organizations' WHERE name = '국제 관계와 국제 기구';
+UPDATE subjects_master SET name_en = 'Global Issues' WHERE name = '세계 문제';
+UPDATE subjects_master SET name_en = 'Comparative Culture' WHERE name = '비교 문화';
+UPDATE subjects_master SET name_en = 'Social Science Methodology' WHERE name = '사회 과학 방법론';
+UPDATE subjects_master SET name_en = 'Korean Society and Culture' WHERE name = '한국의 사회와 문화';
+UPDATE subjects_master SET name_en = 'International Law' WHERE name = '국제법';
+UPDATE subjects_master SET name_en = 'Area Studies' WHERE name = '지역 이해';
+UPDATE subjects_master SET name_en = 'Future Society of Humankind' WHERE name = '인류의 미래 사회';
+UPDATE subjects_master SET name_en = 'Ethics and Life' WHERE name = '생활과 윤리';
+UPDATE subjects_master SET name_en = 'Ethics and Thoughts' WHERE name = '윤리와 사상';
+UPDATE subjects_master SET name_en = 'Research Project' WHERE name = '과제 연구';
+UPDATE subjects_master SET name_en = 'Social Studies' WHERE name = '사회';
+UPDATE subjects_master SET name_en = 'Ethics' WHERE name = '도덕';
+UPDATE subjects_master SET name_en = 'Integrated Social Studies' WHERE name = '통합사회';
+UPDATE subjects_master SET name_en = 'Politics and Law' WHERE name = '정치와 법';
+UPDATE subjects_master SET name_en = 'Social Issues Inquiry' WHERE name = '사회문제 탐구';
+UPDATE subjects_master SET name_en = 'Travel Geography' WHERE name = '여행지리';
+UPDATE subjects_master SET name_en = 'Classics and Ethics' WHERE name = '고전과 윤리';
+UPDATE subjects_master SET name_en = 'Science' WHERE name = '과학';
+UPDATE subjects_master SET name_en = 'Physics I' WHERE name = '물리Ⅰ';
+UPDATE subjects_master SET name_en = 'Physics II' WHERE name = '물리Ⅱ';
+UPDATE subjects_master SET name_en = 'Chemistry I' WHERE name = '화학Ⅰ';
+UPDATE subjects_master SET name_en = 'Chemistry II' WHERE name = '화학Ⅱ';
+UPDATE subjects_master SET name_en = 'Life Science I' WHERE name = '생명과학Ⅰ';
+UPDATE subjects_master SET name_en = 'Life Science II' WHERE name = '생명과학Ⅱ';
+UPDATE subjects_master SET name_en = 'Earth Science I' WHERE name = '지구과학Ⅰ';
+UPDATE subjects_master SET name_en = 'Earth Science II' WHERE name = '지구과학Ⅱ';
+UPDATE subjects_master SET name_en = 'Advanced Physics' WHERE name = '고급 물리';
+UPDATE subjects_master SET name_en = 'Physics Experiments' WHERE name = '물리 실험';
+UPDATE subjects_master SET name_en = 'Advanced Chemistry' WHERE name = '고급 화학';
+UPDATE subjects_master SET name_en = 'Chemistry Experiments' WHERE name = '화학 실험';
+UPDATE subjects_master SET name_en = 'Advanced Life Science' WHERE name = '고급 생명 과학';
+UPDATE subjects_master SET name_en = 'Life Science Experiments' WHERE name = '생명 과학 실험';
+UPDATE subjects_master SET name_en = 'Advanced Earth Science' WHERE name = '고급 지구 과학';
+UPDATE subjects_master SET name_en = 'Earth Science Experiments' WHERE name = '지구 과학 실험';
+UPDATE subjects_master SET name_en = 'Environmental Science' WHERE name = '환경 과학';
+UPDATE subjects_master SET name_en = 'History and Philosophy of Science' WHERE name = '과학사 및 과학 철학';
+UPDATE subjects_master SET name_en = 'Information Science' WHERE name = '정보 과학';
+UPDATE subjects_master SET name_en = 'Convergence Science' WHERE name = '융합과학';
+UPDATE subjects_master SET name_en = 'Integrated Science' WHERE name = '통합과학';
+UPDATE subjects_master SET name_en = 'Science in Life' WHERE name = '생활과 과학';
+UPDATE subjects_master SET name_en = 'History of Science' WHERE name = '과학사';
+UPDATE subjects_master SET name_en = 'Physics I' WHERE name = '물리학Ⅰ';
+UPDATE subjects_master SET name_en = 'Physics II' WHERE name = '물리학Ⅱ';
+UPDATE subjects_master SET name_en = 'Exercise and Healthy Living' WHERE name = '운동과 건강 생활';
+UPDATE subjects_master SET name_en = 'Sports Culture' WHERE name = '스포츠 문화';
+UPDATE subjects_master SET name_en = 'Sports Science' WHERE name = '스포츠 과학';
+UPDATE subjects_master SET name_en = 'Introduction to Sports' WHERE name = '스포츠 개론';
+UPDATE subjects_master SET name_en = 'Physical Education and Career Exploration' WHERE name = '체육과 진로 탐구';
+UPDATE subjects_master SET name_en = 'Track and Field' WHERE name = '육상 운동';
+UPDATE subjects_master SET name_en = 'Gymnastics' WHERE name = '체조 운동';
+UPDATE subjects_master SET name_en = 'Aquatic Sports' WHERE name = '수상 운동';
+UPDATE subjects_master SET name_en = 'Individual and Dual Sports' WHERE name = '개인 및 대인 운동';
+UPDATE subjects_master SET name_en = 'Team Sports' WHERE name = '단체 운동';
+UPDATE subjects_master SET name_en = 'Fitness Training' WHERE name = '체력 운동';
+UPDATE subjects_master SET name_en = 'Combat Sports' WHERE name = '투기 운동';
+UPDATE subjects_master SET name_en = 'Ice and Snow Sports' WHERE name = '빙상 및 설상 운동';
+UPDATE subjects_master SET name_en = 'Expressive and Creative Movement' WHERE name = '표현 및 창작 운동';
+UPDATE subjects_master SET name_en = 'Sports Conditioning' WHERE name = '스포츠경기 체력';
+UPDATE subjects_master SET name_en = 'Sports Skills' WHERE name = '스포츠경기 기술';
+UPDATE subjects_master SET name_en = 'Sports Practice' WHERE name = '스포츠경기 실습';
+UPDATE subjects_master SET name_en = 'Coaching Theory' WHERE name = '코칭론';
+UPDATE subjects_master SET name_en = 'Sports Management and Administration' WHERE name = '스포츠경영·행정';
+UPDATE subjects_master SET name_en = 'Physical Education Major Practice' WHERE name = '체육 전공 실기';
+UPDATE subjects_master SET name_en = 'Major Guidance Practice' WHERE name = '전공 지도 실습';
+UPDATE subjects_master SET name_en = 'Physical Education' WHERE name = '체육';
+UPDATE subjects_master SET name_en = 'Exercise and Health' WHERE name = '운동과 건강';
+UPDATE subjects_master SET name_en = 'Sports Life' WHERE name = '스포츠 생활';
+UPDATE subjects_master SET name_en = 'Physical Education Inquiry' WHERE name = '체육 탐구';
+UPDATE subjects_master SET name_en = 'Understanding Dance' WHERE name = '무용의 이해';
+UPDATE subjects_master SET name_en = 'Basic Korean Dance' WHERE name = '기초 한국 무용';
+UPDATE subjects_master SET name_en = 'Basic Ballet' WHERE name = '기초 발레';
+UPDATE subjects_master SET name_en = 'Basic Modern Dance' WHERE name = '기초 현대 무용';
+UPDATE subjects_master SET name_en = 'Dance Major Practice' WHERE name = '무용 전공 실기';
+UPDATE subjects_master SET name_en = 'Dance Music' WHERE name = '무용 음악';
+UPDATE subjects_master SET name_en = 'Dance Appreciation and Criticism' WHERE name = '무용 감상과 비평';
+UPDATE subjects_master SET name_en = 'Choreography' WHERE name = '안무';
+UPDATE subjects_master SET name_en = 'Introduction to Literature' WHERE name = '문학 개론';
+UPDATE subjects_master SET name_en = 'Sentence Composition' WHERE name = '문장론';
+UPDATE subjects_master SET name_en = 'Appreciation of Classical Literature' WHERE name = '고전 문학 감상';
+UPDATE subjects_master SET name_en = 'Appreciation of Modern Literature' WHERE name = '현대 문학 감상';
+UPDATE subjects_master SET name_en = 'Introduction to Poetry Writing' WHERE name = '시 창작 입문';
+UPDATE subjects_master SET name_en = 'Introduction to Fiction Writing' WHERE name = '소설 창작 입문';
+UPDATE subjects_master SET name_en = 'Creative Writing Major Practice' WHERE name = '문예 창작 전공 실기';
+UPDATE subjects_master SET name_en = 'Understanding Theater' WHERE name = '연극의 이해';
+UPDATE subjects_master SET name_en = 'Acting' WHERE name = '연기';
+UPDATE subjects_master SET name_en = 'Stage Technology' WHERE name = '무대 기술';
+UPDATE subjects_master SET name_en = 'Theater Production Practice' WHERE name = '연극 제작 실습';
+UPDATE subjects_master SET name_en = 'Theater Appreciation and Criticism' WHERE name = '연극 감상과 비평';
+UPDATE subjects_master SET name_en = 'Understanding Film' WHERE name = '영화의 이해';
+UPDATE subjects_master SET name_en = 'Film Technology' WHERE name = '영화기술';
+UPDATE subjects_master SET name_en = 'Film Creation and Expression' WHERE name = '영화 창작과 표현';
+UPDATE subjects_master SET name_en = 'Film Production Practice' WHERE name = '영화 제작 실습';
+UPDATE subjects_master SET name_en = 'Film Appreciation and Criticism' WHERE name = '영화 감상과 비평';
+UPDATE subjects_master SET name_en = 'Understanding Photography' WHERE name = '사진의 이해';
+UPDATE subjects_master SET name_en = 'Basic Photography' WHERE name = '기초 촬영';
+UPDATE subjects_master SET name_en = 'Intermediate Photography' WHERE name = '중급 촬영';
+UPDATE subjects_master SET name_en = 'Darkroom Practice' WHERE name = '암실 실기';
+UPDATE subjects_master SET name_en = 'Photo Editing' WHERE name = '사진 편집';
+UPDATE subjects_master SET name_en = 'Digital Photography' WHERE name = '디지털 사진 촬영';
+UPDATE subjects_master SET name_en = 'Digital Photo Techniques' WHERE name = '디지털 사진 표현 기법';
+UPDATE subjects_master SET name_en = 'Photo Appreciation and Criticism' WHERE name = '사진 감상과 비평';
+UPDATE subjects_master SET name_en = 'Music Appreciation and Criticism' WHERE name = '음악 감상과 비평';
+UPDATE subjects_master SET name_en = 'Art' WHERE name = '미술';
+UPDATE subjects_master SET name_en = 'Art Appreciation and Criticism' WHERE name = '미술 감상과 비평';
+UPDATE subjects_master SET name_en = 'Art Creation' WHERE name = '미술 창작';
+UPDATE subjects_master SET name_en = 'Theater Music Performance' WHERE name = '연극 음악 연주';
+UPDATE subjects_master SET name_en = 'Music' WHERE name = '음악';
+UPDATE subjects_master SET name_en = 'Theater' WHERE name = '연극';
+UPDATE subjects_master SET name_en = 'Music Performance' WHERE name = '음악 연주';
+UPDATE subjects_master SET name_en = 'Pedagogy' WHERE name = '교육학';
+UPDATE subjects_master SET name_en = 'Logic' WHERE name = '논리학';
+UPDATE subjects_master SET name_en = 'Essay Writing' WHERE name = '논술';
+UPDATE subjects_master SET name_en = 'Health' WHERE name = '보건';
+UPDATE subjects_master SET name_en = 'Practical Economics' WHERE name = '실용 경제';
+UPDATE subjects_master SET name_en = 'Psychology' WHERE name = '심리학';
+UPDATE subjects_master SET name_en = 'Religious Studies' WHERE name = '종교학';
+UPDATE subjects_master SET name_en = 'Career and Occupation' WHERE name = '진로와 직업';
+UPDATE subjects_master SET name_en = 'Philosophy' WHERE name = '철학';
+UPDATE subjects_master SET name_en = 'Environment' WHERE name = '환경';
+UPDATE subjects_master SET name_en = 'German I' WHERE name = '독일어Ⅰ';
+UPDATE subjects_master SET name_en = 'German II' WHERE name = '독일어Ⅱ';
+UPDATE subjects_master SET name_en = 'Russian I' WHERE name = '러시아어Ⅰ';
+UPDATE subjects_master SET name_en = 'Russian II' WHERE name = '러시아어Ⅱ';
+UPDATE subjects_master SET name_en = 'Vietnamese I' WHERE name = '베트남어Ⅰ';
+UPDATE subjects_master SET name_en = 'Vietnamese II' WHERE name = '베트남어Ⅱ';
+UPDATE subjects_master SET name_en = 'Spanish I' WHERE name = '스페인어Ⅰ';
+UPDATE subjects_master SET name_en = 'Spanish II' WHERE name = '스페인어Ⅱ';
+UPDATE subjects_master SET name_en = 'Arabic I' WHERE name = '아랍어Ⅰ';
+UPDATE subjects_master SET name_en = 'Arabic II' WHERE name = '아랍어Ⅱ';
+UPDATE subjects_master SET name_en = 'Japanese I' WHERE name = '일본어Ⅰ';
+UPDATE subjects_master SET name_en = 'Japanese II' WHERE name = '일본어Ⅱ';
+UPDATE subjects_master SET name_en = 'Chinese I' WHERE name = '중국어Ⅰ';
+UPDATE subjects_master SET name_en = 'Chinese II' WHERE name = '중국어Ⅱ';
+UPDATE subjects_master SET name_en = 'French I' WHERE name = '프랑스어Ⅰ';
+UPDATE subjects_master SET name_en = 'French II' WHERE name = '프랑스어Ⅱ';
+UPDATE subjects_master SET name_en = 'Classical Chinese I' WHERE name = '한문Ⅰ';
+UPDATE subjects_master SET name_en = 'Classical Chinese II' WHERE name = '한문Ⅱ';
+UPDATE subjects_master SET name_en = 'German' WHERE name = '독일어';
+UPDATE subjects_master SET name_en = 'Russian' WHERE name = '러시아어';
+UPDATE subjects_master SET name_en = 'Vietnamese' WHERE name = '베트남어';
+UPDATE subjects_master SET name_en = 'Spanish' WHERE name = '스페인어';
+UPDATE subjects_master SET name_en = 'Arabic' WHERE name = '아랍어';
+UPDATE subjects_master SET name_en = 'Japanese' WHERE name = '일본어';
+UPDATE subjects_master SET name_en = 'Chinese' WHERE name = '중국어';
+UPDATE subjects_master SET name_en = 'French' WHERE name = '프랑스어';
+UPDATE subjects_master SET name_en = 'Classical Chinese' WHERE name = '한문';
+UPDATE subjects_master SET name_en = 'Common Korean 1' WHERE name = '공통국어1';
+UPDATE subjects_master SET name_en = 'Common Korean 2' WHERE name = '공통국어2';
+UPDATE subjects_master SET name_en = 'Common Mathematics 1' WHERE name = '공통수학1';
+UPDATE subjects_master SET name_en = 'Common Mathematics 2' WHERE name = '공통수학2';
+UPDATE subjects_master SET name_en = 'Fundamental Mathematics 1' WHERE name = '기본수학1';
+UPDATE subjects_master SET name_en = 'Fundamental Mathematics 2' WHERE name = '기본수학2';
+UPDATE subjects_master SET name_en = 'Common English 1' WHERE name = '공통영어1';
+UPDATE subjects_master SET name_en = 'Common English 2' WHERE name = '공통영어2';
+UPDATE subjects_master SET name_en = 'Fundamental English 1' WHERE name = '기본영어1';
+UPDATE subjects_master SET name_en = 'Fundamental English 2' WHERE name = '기본영어2';
+UPDATE subjects_master SET name_en = 'Korean History 1' WHERE name = '한국사1';
+UPDATE subjects_master SET name_en = 'Korean History 2' WHERE name = '한국사2';
+UPDATE subjects_master SET name_en = 'Integrated Social Studies 1' WHERE name = '통합사회1';
+UPDATE subjects_master SET name_en = 'Integrated Social Studies 2' WHERE name = '통합사회2';
+UPDATE subjects_master SET name_en = 'Integrated Science 1' WHERE name = '통합과학1';
+UPDATE subjects_master SET name_en = 'Integrated Science 2' WHERE name = '통합과학2';
+UPDATE subjects_master SET name_en = 'Integrated Science Experiment 1' WHERE name = '과학탐구실험1';
+UPDATE subjects_master SET name_en = 'Integrated Science Experiment 2' WHERE name = '과학탐구실험2';
+UPDATE subjects_master SET name_en = 'Speech and Language' WHERE name = '화법과 언어';
+UPDATE subjects_master SET name_en = 'Reading and Writing' WHERE name = '독서와 작문';
+UPDATE subjects_master SET name_en = 'Algebra' WHERE name = '대수';
+UPDATE subjects_master SET name_en = 'Global Citizenship and Geography' WHERE name = '세계시민과 지리';
+UPDATE subjects_master SET name_en = 'Society and Culture' WHERE name = '사회와 문화';
+UPDATE subjects_master SET name_en = 'Ethics in Modern Society' WHERE name = '현대사회와 윤리';
+UPDATE subjects_master SET name_en = 'Physics' WHERE name = '물리학';
+UPDATE subjects_master SET name_en = 'Chemistry' WHERE name = '화학';
+UPDATE subjects_master SET name_en = 'Life Science' WHERE name = '생명과학';
+UPDATE subjects_master SET name_en = 'Earth Science' WHERE name = '지구과학';
+UPDATE subjects_master SET name_en = 'Thematic Inquiry Reading' WHERE name = '주제 탐구 독서';
+UPDATE subjects_master SET name_en = 'Literature and Film' WHERE name = '문학과 영상';
+UPDATE subjects_master SET name_en = 'Workplace Communication' WHERE name = '직무 의사소통';
+UPDATE subjects_master SET name_en = 'Workplace Mathematics' WHERE name = '직무 수학';
+UPDATE subjects_master SET name_en = 'Reading English Literature' WHERE name = '영미 문학 읽기';
+UPDATE subjects_master SET name_en = 'English Presentation and Debate' WHERE name = '영어 발표와 토론';
+UPDATE subjects_master SET name_en = 'Advanced English Reading and Writing' WHERE name = '심화 영어 독해와 작문';
+UPDATE subjects_master SET name_en = 'Workplace English' WHERE name = '직무 영어';
+UPDATE subjects_master SET name_en = 'Korean Geography Inquiry' WHERE name = '한국지리 탐구';
+UPDATE subjects_master SET name_en = 'Exploring the Future of Cities' WHERE name = '도시의 미래 탐구';
+UPDATE subjects_master SET name_en = 'Journey through East Asian History' WHERE name = '동아시아 역사 기행';
+UPDATE subjects_master SET name_en = 'Politics' WHERE name = '정치';
+UPDATE subjects_master SET name_en = 'Law and Society' WHERE name = '법과 사회';
+UPDATE subjects_master SET name_en = 'Humanities and Ethics' WHERE name = '인문학과 윤리';
+UPDATE subjects_master SET name_en = 'Understanding International Relations' WHERE name = '국제 관계의 이해';
+UPDATE subjects_master SET name_en = 'Mechanics and Energy' WHERE name = '역학과 에너지';
+UPDATE subjects_master SET name_en = 'Electromagnetism and Quantum Physics' WHERE name = '전자기와 양자';
+UPDATE subjects_master SET name_en = 'Matter and Energy' WHERE name = '물질과 에너지';
+UPDATE subjects_master SET name_en = 'The World of Chemical Reactions' WHERE name = '화학 반응의 세계';
+UPDATE subjects_master SET name_en = 'Cells and Metabolism' WHERE name = '세포와 물질대사';
+UPDATE subjects_master SET name_en = 'Heredity' WHERE name = '생물의 유전';
+UPDATE subjects_master SET name_en = 'Earth System Science' WHERE name = '지구시스템과학';
+UPDATE subjects_master SET name_en = 'Planetary and Space Science' WHERE name = '행성우주과학';
+UPDATE subjects_master SET name_en = 'Reading, Discussion and Writing' WHERE name = '독서 토론과 글쓰기';
+UPDATE subjects_master SET name_en = 'Media Communication' WHERE name = '매체 의사소통';
+UPDATE subjects_master SET name_en = 'Inquiry into Language Use' WHERE name = '언어생활 탐구';
+UPDATE subjects_master SET name_en = 'Mathematics and Culture' WHERE name = '수학과 문화';
+UPDATE subjects_master SET name_en = 'Practical Statistics' WHERE name = '실용 통계';
+UPDATE subjects_master SET name_en = 'Everyday English Conversation' WHERE name = '실생활 영어 회화';
+UPDATE subjects_master SET name_en = 'Media English' WHERE name = '미디어 영어';
+UPDATE subjects_master SET name_en = 'World Cultures and English' WHERE name = '세계 문화와 영어';
+UPDATE subjects_master SET name_en = 'Exploring the Modern World through History' WHERE name = '역사로 탐구하는 현대 세계';
+UPDATE subjects_master SET name_en = 'Finance and Economic Life' WHERE name = '금융과 경제생활';
+UPDATE subjects_master SET name_en = 'Ethical Issues Inquiry' WHERE name = '윤리문제 탐구';
+UPDATE subjects_master SET name_en = 'Climate Change and a Sustainable World' WHERE name = '기후변화와 지속가능한 세계';
+UPDATE subjects_master SET name_en = 'History and Culture of Science' WHERE name = '과학의 역사와 문화';
+UPDATE subjects_master SET name_en = 'Climate Change and Ecology' WHERE name = '기후변화와 환경생태';
+UPDATE subjects_master SET name_en = 'Convergence Science Inquiry' WHERE name = '융합과학 탐구';
+UPDATE subjects_master SET name_en = 'Successful Working Life' WHERE name = '성공적인 직업생활';
+UPDATE subjects_master SET name_en = 'Basic Agricultural Technology' WHERE name = '농업 기초 기술';
+UPDATE subjects_master SET name_en = 'General Industry' WHERE name = '공업 일반';
+UPDATE subjects_master SET name_en = 'Commercial Economics' WHERE name = '상업 경제';
+UPDATE subjects_master SET name_en = 'Fundamentals of Fisheries and Shipping' WHERE name = '수산·해운 산업 기초';
+UPDATE subjects_master SET name_en = 'Human Development' WHERE name = '인간 발달';
+
+INSERT INTO subject_catalog_versions (version, note) VALUES
+    ('name-en-seed', '교과구분종류, 교과, 과목, 수능 영역의 영어 이름 추가')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS admission_rules;
+DROP TABLE IF EXISTS departments;
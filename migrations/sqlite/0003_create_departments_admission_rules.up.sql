@@ -0,0 +1,28 @@
+-- 마이그레이션 도입 전 `univ import` 가 직접 만든 테이블이 남아 있을 수 있으므로 IF NOT EXISTS 를 사용합니다.
+-- 학과(모집단위) 정보. `univ import` 로 적재합니다.
+CREATE TABLE IF NOT EXISTS departments (
+    id              TEXT PRIMARY KEY,   -- DepartmentID (handlers/ids.go)
+    university_id   TEXT NOT NULL,      -- UniversityID
+    campus_id       TEXT NOT NULL,      -- CampusID
+    university_name TEXT NOT NULL,
+    campus          TEXT NOT NULL DEFAULT '',
+    name            TEXT NOT NULL,
+    department_code TEXT NOT NULL DEFAULT '',
+    region          TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_departments_department_code ON departments (department_code);
+CREATE INDEX IF NOT EXISTS idx_departments_region ON departments (region);
+CREATE INDEX IF NOT EXISTS idx_departments_university_id ON departments (university_id);
+
+-- 전형별 작년도 입시 결과. `univ import` 로 적재합니다.
+CREATE TABLE IF NOT EXISTS admission_rules (
+    id                    TEXT PRIMARY KEY, -- AdmissionProgramID
+    department_id         TEXT NOT NULL REFERENCES departments (id) ON DELETE CASCADE,
+    admission_type        TEXT NOT NULL,
+    detail_admission_type TEXT NOT NULL DEFAULT '',
+    competition_rate      REAL,
+    cut50                 REAL,
+    cut70                 REAL
+);
+CREATE INDEX IF NOT EXISTS idx_admission_rules_department_id ON admission_rules (department_id);
+CREATE INDEX IF NOT EXISTS idx_admission_rules_admission_type ON admission_rules (admission_type);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS subjects_master;
+DROP TABLE IF EXISTS subject_curriculums;
+DROP TABLE IF EXISTS subject_classifications;
+DROP TABLE IF EXISTS subject_catalog_versions;
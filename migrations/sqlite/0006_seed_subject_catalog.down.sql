@@ -0,0 +1,5 @@
+-- 초기 데이터와 이후 `univ import-subjects` 로 적재한 목록을 모두 비웁니다.
+DELETE FROM subjects_master;
+DELETE FROM subject_curriculums;
+DELETE FROM subject_classifications;
+DELETE FROM subject_catalog_versions;
@@ -0,0 +1,1130 @@
+-- 과목 목록 초기 데이터. 기존 handlers/subject.go 에 하드코딩되어 있던 목록을 옮겼습니다.
+-- 이후 수정은 `univ export-subjects` 로 내려받아 고친 뒤 `univ import-subjects` 로 적재합니다.
+INSERT OR IGNORE INTO subject_catalog_versions (version, note) VALUES
+    ('2015-seed', '초기 데이터 (handlers/subject.go 에서 이전)');
+
+INSERT OR IGNORE INTO subject_classifications (code, name, display_order) VALUES
+    ('CLASS_COMMON_SELECT', '일반선택', 1),
+    ('CLASS_CAREER_SELECT', '진로선택', 2),
+    ('CLASS_SCIENCE_QUEST', '과학탐구실험', 3);
+
+INSERT OR IGNORE INTO subject_curriculums (classification_code, code, name, display_order) VALUES
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_KOR_SELECT', '국어', 1),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_MATH_SELECT', '수학', 2),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_ENG_SELECT', '영어', 3),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_SOCIETY_SELECT', '사회(역사/도덕포함)', 4),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_SCIENCE_SELECT', '과학', 5),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_PE_SELECT', '체육', 6),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_ART_SELECT', '예술', 7),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_INDUSTRY_SELECT', '공업', 8),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_OCEAN_SELECT', '수산·해운', 9),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_DESIGN_SELECT', '디자인·문화콘텐츠', 10),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_LEISURE_SELECT', '미용·관광·레저', 11),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_FOOD_SELECT', '식품·가공', 12),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_ELECTRON_SELECT', '전기·전자', 13),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_CONSTRUCTION_SELECT', '건설', 14),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_ECONOMY_SELECT', '경영·금융', 15),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_SCIENTIFIC_SELECT', '과학 계열', 16),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_CULTURE_SELECT', '교양', 17),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_INTERNATIONAL_SELECT', '국제 계열', 18),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_MECHANIC_SELECT', '기계', 19),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', '기술·가정', 20),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', '농림·수산해양', 21),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_HEALTH_WELFARE_SELECT', '보건·복지', 22),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_SHIP_OPERATION_SELECT', '선박 운항', 23),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', '섬유·의류', 24),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_ARTS_SELECT', '예술 계열', 25),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', '외국어 계열', 26),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_FOOD_COOKING_SELECT', '음식 조리', 27),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', '인쇄·출판·공예', 28),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_MATERIALS_SELECT', '재료', 29),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', '정보·통신', 30),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', '제2외국어', 31),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', '체육 계열', 32),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_KOREAN_HISTORY_SELECT', '한국사', 33),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_CLASSICAL_CHINESE_SELECT', '한문', 34),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', '화학공업', 35),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', '환경·안전', 36),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_KOR_SELECT', '국어', 37),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_MATH_SELECT', '수학', 38),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ENG_SELECT', '영어', 39),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_SOCIETY_SELECT', '사회(역사/도덕포함)', 40),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_SCIENCE_SELECT', '과학', 41),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_PE_SELECT', '체육', 42),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ART_SELECT', '예술', 43),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_INDUSTRY_SELECT', '공업', 44),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_OCEAN_SELECT', '수산·해운', 45),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_DESIGN_SELECT', '디자인·문화콘텐츠', 46),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_LEISURE_SELECT', '미용·관광·레저', 47),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_FOOD_SELECT', '식품·가공', 48),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ELECTRON_SELECT', '전기·전자', 49),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_CONSTRUCTION_SELECT', '건설', 50),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ECONOMY_SELECT', '경영·금융', 51),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_SCIENTIFIC_SELECT', '과학 계열', 52),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_CULTURE_SELECT', '교양', 53),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_INTERNATIONAL_SELECT', '국제 계열', 54),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_MECHANIC_SELECT', '기계', 55),
+    ('CLASS_COMMON_SELECT', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', '기술·가정', 56),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', '농림·수산해양', 57),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_HEALTH_WELFARE_SELECT', '보건·복지', 58),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_SHIP_OPERATION_SELECT', '선박 운항', 59),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', '섬유·의류', 60),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ARTS_SELECT', '예술 계열', 61),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', '외국어 계열', 62),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_FOOD_COOKING_SELECT', '음식 조리', 63),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', '인쇄·출판·공예', 64),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_MATERIALS_SELECT', '재료', 65),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', '정보·통신', 66),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', '제2외국어', 67),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', '체육 계열', 68),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_KOREAN_HISTORY_SELECT', '한국사', 69),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_CLASSICAL_CHINESE_SELECT', '한문', 70),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', '화학공업', 71),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', '환경·안전', 72),
+    ('CLASS_SCIENCE_QUEST', 'CURR_SCIENCE_QUEST_SELECT', '과학', 73);
+
+INSERT OR IGNORE INTO subjects_master (subject_type, parent_code, code, name, display_order) VALUES
+    ('naesin', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', 'NAESIN_가정과학', '가정과학', 1),
+    ('naesin', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', 'NAESIN_공학_일반', '공학 일반', 2),
+    ('naesin', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', 'NAESIN_기술·가정', '기술·가정', 3),
+    ('naesin', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', 'NAESIN_농업_생명_과학', '농업 생명 과학', 4),
+    ('naesin', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', 'NAESIN_정보', '정보', 5),
+    ('naesin', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', 'NAESIN_지식_재산_일반', '지식 재산 일반', 6),
+    ('naesin', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', 'NAESIN_창의_경영', '창의 경영', 7),
+    ('naesin', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', 'NAESIN_해양_문화와_기술', '해양 문화와 기술', 8),
+    ('naesin', 'CURR_COMMON_MECHANIC_HOUSE_SELECT', 'NAESIN_인공지능_기초', '인공지능 기초', 9),
+    ('naesin', 'CURR_SCIENCE_QUEST_SELECT', 'NAESIN_과학탐구실험', '과학탐구실험', 10),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_국어Ⅰ', '국어Ⅰ', 11),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_국어Ⅱ', '국어Ⅱ', 12),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_화법과_작문', '화법과 작문', 13),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_독서와_문법', '독서와 문법', 14),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_문학', '문학', 15),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_고전', '고전', 16),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_국어', '국어', 17),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_현대문학감상', '현대문학감상', 18),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_독서', '독서', 19),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_실용_국어', '실용 국어', 20),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_언어와매체', '언어와매체', 21),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_심화국어', '심화국어', 22),
+    ('naesin', 'CURR_COMMON_KOR_SELECT', 'NAESIN_고전_읽기', '고전 읽기', 23),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_기초_수학', '기초 수학', 24),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_수학Ⅰ', '수학Ⅰ', 25),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_수학Ⅱ', '수학Ⅱ', 26),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_확률과_통계', '확률과 통계', 27),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_미적분Ⅰ', '미적분Ⅰ', 28),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_미적분Ⅱ', '미적분Ⅱ', 29),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_기하와_벡터', '기하와 벡터', 30),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_고급_수학Ⅰ', '고급 수학Ⅰ', 31),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_고급_수학Ⅱ', '고급 수학Ⅱ', 32),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_수학', '수학', 33),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_미적분', '미적분', 34),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_수학과제_탐구', '수학과제 탐구', 35),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_경제_수학', '경제 수학', 36),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_기하', '기하', 37),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_실용_수학', '실용 수학', 38),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_기본_수학', '기본 수학', 39),
+    ('naesin', 'CURR_COMMON_MATH_SELECT', 'NAESIN_인공지능_수학', '인공지능 수학', 40),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_기초_영어', '기초 영어', 41),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_실용_영어Ⅰ', '실용 영어Ⅰ', 42),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_실용_영어Ⅱ', '실용 영어Ⅱ', 43),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_실용_영어_회화', '실용 영어 회화', 44),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_실용_영어_독해와_작문', '실용 영어 독해와 작문', 45),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_영어Ⅰ', '영어Ⅰ', 46),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_영어Ⅱ', '영어Ⅱ', 47),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_영어_회화', '영어 회화', 48),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_영어_독해와_작문', '영어 독해와 작문', 49),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_심화_영어', '심화 영어', 50),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_심화_영어_회화Ⅰ', '심화 영어 회화Ⅰ', 51),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_심화_영어_회화Ⅱ', '심화 영어 회화Ⅱ', 52),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_심화_영어_독해Ⅰ', '심화 영어 독해Ⅰ', 53),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_심화_영어_독해Ⅱ', '심화 영어 독해Ⅱ', 54),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_심화_영어_작문', '심화 영어 작문', 55),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_영어', '영어', 56),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_실용_영어', '실용 영어', 57),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_영미문학읽기', '영미문학읽기', 58),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_진로영어', '진로영어', 59),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_영어권_문화', '영어권 문화', 60),
+    ('naesin', 'CURR_COMMON_ENG_SELECT', 'NAESIN_기본_영어', '기본 영어', 61),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_한국지리', '한국지리', 62),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_세계지리', '세계지리', 63),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_한국사', '한국사', 64),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_동아시아사', '동아시아사', 65),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_세계사', '세계사', 66),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_경제', '경제', 67),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_법과_정치', '법과 정치', 68),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_사회·문화', '사회·문화', 69),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_국제_정치', '국제 정치', 70),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_국제_경제', '국제 경제', 71),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_국제_관계와_국제_기구', '국제 관계와 국제 기구', 72),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_세계_문제', '세계 문제', 73),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_비교_문화', '비교 문화', 74),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_사회_과학_방법론', '사회 과학 방법론', 75),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_한국의_사회와_문화', '한국의 사회와 문화', 76),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_국제법', '국제법', 77),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_지역_이해', '지역 이해', 78),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_인류의_미래_사회', '인류의 미래 사회', 79),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_생활과_윤리', '생활과 윤리', 80),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_윤리와_사상', '윤리와 사상', 81),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_과제_연구', '과제 연구', 82),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_사회', '사회', 83),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_도덕', '도덕', 84),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_통합사회', '통합사회', 85),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_정치와_법', '정치와 법', 86),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_사회문제_탐구', '사회문제 탐구', 87),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_여행지리', '여행지리', 88),
+    ('naesin', 'CURR_COMMON_SOCIETY_SELECT', 'NAESIN_고전과_윤리', '고전과 윤리', 89),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_과학', '과학', 90),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_물리Ⅰ', '물리Ⅰ', 91),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_물리Ⅱ', '물리Ⅱ', 92),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_화학Ⅰ', '화학Ⅰ', 93),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_화학Ⅱ', '화학Ⅱ', 94),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_생명과학Ⅰ', '생명과학Ⅰ', 95),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_생명과학Ⅱ', '생명과학Ⅱ', 96),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_지구과학Ⅰ', '지구과학Ⅰ', 97),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_지구과학Ⅱ', '지구과학Ⅱ', 98),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_고급_물리', '고급 물리', 99),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_물리_실험', '물리 실험', 100),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_고급_화학', '고급 화학', 101),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_화학_실험', '화학 실험', 102),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_고급_생명_과학', '고급 생명 과학', 103),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_생명_과학_실험', '생명 과학 실험', 104),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_고급_지구_과학', '고급 지구 과학', 105),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_지구_과학_실험', '지구 과학 실험', 106),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_환경_과학', '환경 과학', 107),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_과학사_및_과학_철학', '과학사 및 과학 철학', 108),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_정보_과학', '정보 과학', 109),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_과제_연구', '과제 연구', 110),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_융합과학', '융합과학', 111),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_통합과학', '통합과학', 112),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_생활과_과학', '생활과 과학', 113),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_과학사', '과학사', 114),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_물리학Ⅰ', '물리학Ⅰ', 115),
+    ('naesin', 'CURR_COMMON_SCIENCE_SELECT', 'NAESIN_물리학Ⅱ', '물리학Ⅱ', 116),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_운동과_건강_생활', '운동과 건강 생활', 117),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_스포츠_문화', '스포츠 문화', 118),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_스포츠_과학', '스포츠 과학', 119),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_스포츠_개론', '스포츠 개론', 120),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_체육과_진로_탐구', '체육과 진로 탐구', 121),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_육상_운동', '육상 운동', 122),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_체조_운동', '체조 운동', 123),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_수상_운동', '수상 운동', 124),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_개인_및_대인_운동', '개인 및 대인 운동', 125),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_단체_운동', '단체 운동', 126),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_체력_운동', '체력 운동', 127),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_투기_운동', '투기 운동', 128),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_빙상_및_설상_운동', '빙상 및 설상 운동', 129),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_표현_및_창작_운동', '표현 및 창작 운동', 130),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_스포츠경기_체력', '스포츠경기 체력', 131),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_스포츠경기_기술', '스포츠경기 기술', 132),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_스포츠경기_실습', '스포츠경기 실습', 133),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_코칭론', '코칭론', 134),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_스포츠경영·행정', '스포츠경영·행정', 135),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_체육_전공_실기', '체육 전공 실기', 136),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_전공_지도_실습', '전공 지도 실습', 137),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_체육', '체육', 138),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_운동과_건강', '운동과 건강', 139),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_스포츠_생활', '스포츠 생활', 140),
+    ('naesin', 'CURR_COMMON_PE_SELECT', 'NAESIN_체육_탐구', '체육 탐구', 141),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_무용의_이해', '무용의 이해', 142),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_기초_한국_무용', '기초 한국 무용', 143),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_기초_발레', '기초 발레', 144),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_기초_현대_무용', '기초 현대 무용', 145),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_무용_전공_실기', '무용 전공 실기', 146),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_무용_음악', '무용 음악', 147),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_무용_감상과_비평', '무용 감상과 비평', 148),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_안무', '안무', 149),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_문학_개론', '문학 개론', 150),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_문장론', '문장론', 151),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_고전_문학_감상', '고전 문학 감상', 152),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_현대_문학_감상', '현대 문학 감상', 153),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_시_창작_입문', '시 창작 입문', 154),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_소설_창작_입문', '소설 창작 입문', 155),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_문예_창작_전공_실기', '문예 창작 전공 실기', 156),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_연극의_이해', '연극의 이해', 157),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_연기', '연기', 158),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_무대_기술', '무대 기술', 159),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_연극_제작_실습', '연극 제작 실습', 160),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_연극_감상과_비평', '연극 감상과 비평', 161),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_영화의_이해', '영화의 이해', 162),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_영화기술', '영화기술', 163),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_영화_창작과_표현', '영화 창작과 표현', 164),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_영화_제작_실습', '영화 제작 실습', 165),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_영화_감상과_비평', '영화 감상과 비평', 166),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_사진의_이해', '사진의 이해', 167),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_기초_촬영', '기초 촬영', 168),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_중급_촬영', '중급 촬영', 169),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_암실_실기', '암실 실기', 170),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_사진_편집', '사진 편집', 171),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_디지털_사진_촬영', '디지털 사진 촬영', 172),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_디지털_사진_표현_기법', '디지털 사진 표현 기법', 173),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_사진_감상과_비평', '사진 감상과 비평', 174),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_음악_감상과_비평', '음악 감상과 비평', 175),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_미술', '미술', 176),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_미술_감상과_비평', '미술 감상과 비평', 177),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_미술_창작', '미술 창작', 178),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_연극_음악_연주', '연극 음악 연주', 179),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_음악', '음악', 180),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_연극', '연극', 181),
+    ('naesin', 'CURR_COMMON_ART_SELECT', 'NAESIN_음악_연주', '음악 연주', 182),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_공업_일반', '공업 일반', 183),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_전문_제도', '전문 제도', 184),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_기계_공작법', '기계 공작법', 185),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_공작_기계', '공작 기계', 186),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_전자_기계_회로', '전자 기계 회로', 187),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_로봇_제작', '로봇 제작', 188),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_주조', '주조', 189),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_전력_설비', '전력 설비', 190),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_전자_회로', '전자 회로', 191),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_정보_통신', '정보 통신', 192),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_프로그래밍', '프로그래밍', 193),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_토목_설계', '토목 설계', 194),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_지적_실무', '지적 실무', 195),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_건축_목공', '건축 목공', 196),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_색채_관리', '색채 관리', 197),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_시각_디자인', '시각 디자인', 198),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_제조_화학', '제조 화학', 199),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_세라믹_원리·공정', '세라믹 원리·공정', 200),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_식품_공업_기술', '식품 공업 기술', 201),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_염색·가공', '염색·가공', 202),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_제판_실제', '제판 실제', 203),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_자동차_섀시', '자동차 섀시', 204),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_선박_이론', '선박 이론', 205),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_항공기_기체', '항공기 기체', 206),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_인간과_환경', '인간과 환경', 207),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_컴퓨터_게임_기획', '컴퓨터 게임 기획', 208),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_애니메이션_제작', '애니메이션 제작', 209),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_방송_시스템', '방송 시스템', 210),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_기초_제도', '기초 제도', 211),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_기계_일반', '기계 일반', 212),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_유체_기계', '유체 기계', 213),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_산업_설비', '산업 설비', 214),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_전자_기계_공작', '전자 기계 공작', 215),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_재료_일반', '재료 일반', 216),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_금속_처리', '금속 처리', 217),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_전기·전자_측정', '전기·전자 측정', 218),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_전자_기기', '전자 기기', 219),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_통신_시스템', '통신 시스템', 220),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_디지털_논리_회로', '디지털 논리 회로', 221),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_토목_재료·시공', '토목 재료·시공', 222),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_공간_정보', '공간 정보', 223),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_건축_구조체_시공', '건축 구조체 시공', 224),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_조형', '조형', 225),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_컴퓨터_그래픽', '컴퓨터 그래픽', 226),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_분석_화학', '분석 화학', 227),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_스마트_세라믹', '스마트 세라믹', 228),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_섬유_재료', '섬유 재료', 229),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_인쇄의_이해', '인쇄의 이해', 230),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_사진', '사진', 231),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_자동차전기·전자제어', '자동차전기·전자제어', 232),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_선박_구조', '선박 구조', 233),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_항공기_기관', '항공기 기관', 234),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_수질_관리', '수질 관리', 235),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_컴퓨터_게임_프로그래밍', '컴퓨터 게임 프로그래밍', 236),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_만화_창작', '만화 창작', 237),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_정보기술과_활용', '정보기술과 활용', 238),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_전기와_생활', '전기와 생활', 239),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_공기_조화_설비', '공기 조화 설비', 240),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_금형_제작', '금형 제작', 241),
+    ('naesin', 'CURR_COMMON_INDUSTRY_SELECT', 'NAESIN_공업계열', '공업계열', 242),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_수산_일반', '수산 일반', 243),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_수산_생물', '수산 생물', 244),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_양식_생물_질병', '양식 생물 질병', 245),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_냉동_일반', '냉동 일반', 246),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_선박_보조_기계', '선박 보조 기계', 247),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_항해', '항해', 248),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_선화_운송', '선화 운송', 249),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_생선회_실무', '생선회 실무', 250),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_해양_플랜트_일반', '해양 플랜트 일반', 251),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_해사_일반', '해사 일반', 252),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_수산_경영_일반', '수산 경영 일반', 253),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_수산_가공', '수산 가공', 254),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_냉동_공조_기기', '냉동 공조 기기', 255),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_선박_전기·전자', '선박 전기·전자', 256),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_선박_운용', '선박 운용', 257),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_전자_통신_기초', '전자 통신 기초', 258),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_해양_레저·관광', '해양 레저·관광', 259),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_해양의_이해', '해양의 이해', 260),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_해양_생산_기술', '해양 생산 기술', 261),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_수산물_유통', '수산물 유통', 262),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_냉동_공조_실무', '냉동 공조 실무', 263),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_기계_설계·공작', '기계 설계·공작', 264),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_해사_법규', '해사 법규', 265),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_전자_통신_기기', '전자 통신 기기', 266),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_해양_물류_일반', '해양 물류 일반', 267),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_수산·해운_산업_기초', '수산·해운 산업 기초', 268),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_수산_양식', '수산 양식', 269),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_해양_오염·방제', '해양 오염·방제', 270),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_열기관', '열기관', 271),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_잠수_기술', '잠수 기술', 272),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_해사_영어', '해사 영어', 273),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_전자_통신_운용', '전자 통신 운용', 274),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_해양_정보_관리', '해양 정보 관리', 275),
+    ('naesin', 'CURR_COMMON_OCEAN_SELECT', 'NAESIN_수산·해운계열', '수산·해운계열', 276),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_광고_콘텐츠_제작', '광고 콘텐츠 제작', 277),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_게임_기획', '게임 기획', 278),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_게임_디자인', '게임 디자인', 279),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_게임_프로그래밍', '게임 프로그래밍', 280),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_디자인_일반', '디자인 일반', 281),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_디자인_제도', '디자인 제도', 282),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_디지털_디자인', '디지털 디자인', 283),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_만화_콘텐츠_제작', '만화 콘텐츠 제작', 284),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_문화_콘텐츠_산업_일반', '문화 콘텐츠 산업 일반', 285),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_미디어_콘텐츠_일반', '미디어 콘텐츠 일반', 286),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_방송_콘텐츠_제작', '방송 콘텐츠 제작', 287),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_색채_관리', '색채 관리', 288),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_색채_디자인', '색채 디자인', 289),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_스마트_문화_앱_콘텐츠_제작', '스마트 문화 앱 콘텐츠 제작', 290),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_시각_디자인', '시각 디자인', 291),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_실내_디자인', '실내 디자인', 292),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_애니메이션_콘텐츠_제작', '애니메이션 콘텐츠 제작', 293),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_영상_제작_기초', '영상 제작 기초', 294),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_영화_콘텐츠_제작', '영화 콘텐츠 제작', 295),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_음악_콘텐츠_제작', '음악 콘텐츠 제작', 296),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_제품_디자인', '제품 디자인', 297),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_조형', '조형', 298),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_캐릭터_제작', '캐릭터 제작', 299),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_컴퓨터_그래픽', '컴퓨터 그래픽', 300),
+    ('naesin', 'CURR_COMMON_DESIGN_SELECT', 'NAESIN_디자인·문화_콘텐츠계열', '디자인·문화 콘텐츠계열', 301),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_호텔_식음료_서비스_실무', '호텔 식음료 서비스 실무', 302),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_관광_사업', '관광 사업', 303),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_관광_서비스', '관광 서비스', 304),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_관광_영어', '관광 영어', 305),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_관광_일반', '관광 일반', 306),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_관광_일본어', '관광 일본어', 307),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_관광_중국어', '관광 중국어', 308),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_네일_미용', '네일 미용', 309),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_메이크업', '메이크업', 310),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_미용_안전·보건', '미용 안전·보건', 311),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_미용의_기초', '미용의 기초', 312),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_여행_서비스_실무', '여행 서비스 실무', 313),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_유원_시설_서비스_실무', '유원 시설 서비스 실무', 314),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_카지노_서비스_실무', '카지노 서비스 실무', 315),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_피부_미용', '피부 미용', 316),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_헤어_미용', '헤어 미용', 317),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_호텔_객실_서비스_실무', '호텔 객실 서비스 실무', 318),
+    ('naesin', 'CURR_COMMON_LEISURE_SELECT', 'NAESIN_미용·관광·레저계열', '미용·관광·레저계열', 319),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_식품_위생', '식품 위생', 320),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_건강_기능_식품_가공', '건강 기능 식품 가공', 321),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_곡물_가공', '곡물 가공', 322),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_김치·반찬_가공', '김치·반찬 가공', 323),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_농산_식품_유통', '농산 식품 유통', 324),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_농산_식품_저장', '농산 식품 저장', 325),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_두류_식품_가공', '두류 식품 가공', 326),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_떡_제조', '떡 제조', 327),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_면류_식품_가공', '면류 식품 가공', 328),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_수산_식품_가공', '수산 식품 가공', 329),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_식품_가공_기술', '식품 가공 기술', 330),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_식품_과학', '식품 과학', 331),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_식품_분석', '식품 분석', 332),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_식품_품질_관리', '식품 품질 관리', 333),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_유제품_가공', '유제품 가공', 334),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_음료·주류_가공', '음료·주류 가공', 335),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_제과', '제과', 336),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_제빵', '제빵', 337),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_축산_식품_가공', '축산 식품 가공', 338),
+    ('naesin', 'CURR_COMMON_FOOD_SELECT', 'NAESIN_식품_가공계열', '식품 가공계열', 339),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_부품_생산', '전자 부품 생산', 340),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_부품_기구_개발', '전자 부품 기구 개발', 341),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_정보_통신_기기_기구_개발', '정보 통신 기기 기구 개발', 342),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_직류_송배전_제어_·보호_시스템_설비_제작', '직류 송배전 제어 ·보호 시스템 설비 제작', 343),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_철도_신호_제어_시공', '철도 신호 제어 시공', 344),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_철도_신호_제어_시설물_유지_보수', '철도 신호 제어 시설물 유지 보수', 345),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_화력_발전_설비_운영', '화력 발전 설비 운영', 346),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_3D_프린터_개발', '3D 프린터 개발', 347),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_3D_프린터용_제품_제작', '3D 프린터용 제품 제작', 348),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_LED_기술_개발', 'LED 기술 개발', 349),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_가상_훈련_구동_엔지니어링', '가상 훈련 구동 엔지니어링', 350),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_가상_훈련_시스템_설계·검증', '가상 훈련 시스템 설계·검증', 351),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_가전_기기_기구_개발', '가전 기기 기구 개발', 352),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_가전_기기_시스템_소프트웨어_개발', '가전 기기 시스템 소프트웨어 개발', 353),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_가전_기기_응용_소프트웨어_개발', '가전 기기 응용 소프트웨어 개발', 354),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_가전_기기_하드웨어_개발', '가전 기기 하드웨어 개발', 355),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_광부품_개발', '광부품 개발', 356),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_내선_공사', '내선 공사', 357),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_디스플레이_개발', '디스플레이 개발', 358),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_디스플레이_생산', '디스플레이 생산', 359),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_디스플레이_장비_부품_개발', '디스플레이 장비 부품 개발', 360),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_디지털_논리_회로', '디지털 논리 회로', 361),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_레이저_개발', '레이저 개발', 362),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_로봇_기구_개발', '로봇 기구 개발', 363),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_로봇_소프트웨어_개발', '로봇 소프트웨어 개발', 364),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_로봇_유지_보수', '로봇 유지 보수', 365),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_로봇_지능_개발', '로봇 지능 개발', 366),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_로봇_하드웨어_설계', '로봇 하드웨어 설계', 367),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_반도체_개발', '반도체 개발', 368),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_반도체_장비', '반도체 장비', 369),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_반도체_재료', '반도체 재료', 370),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_반도체_제조', '반도체 제조', 371),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_변전_설비_공사', '변전 설비 공사', 372),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_산업용_전자_기기_기구_개발', '산업용 전자 기기 기구 개발', 373),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_산업용_전자_기기_소프트웨어_개발', '산업용 전자 기기 소프트웨어 개발', 374),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_산업용_전자_기기_하드웨어_개발', '산업용 전자 기기 하드웨어 개발', 375),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_송·변전_배전_설비_운영', '송·변전 배전 설비 운영', 376),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_수력_발전_설비_운영', '수력 발전 설비 운영', 377),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_외선_공사', '외선 공사', 378),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_원자력_발전_설비_운영', '원자력 발전 설비 운영', 379),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_원자력_발전_전기_설비_정비', '원자력 발전 전기 설비 정비', 380),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_의료_기기_생산', '의료 기기 생산', 381),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_의료_기기_연구·개발', '의료 기기 연구·개발', 382),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_의료_기기_인허가', '의료 기기 인허가', 383),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_자동_제어_기기_제작', '자동 제어 기기 제작', 384),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_자동_제어_시스템_운영', '자동 제어 시스템 운영', 385),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_자동_제어_시스템_유지_정비', '자동 제어 시스템 유지 정비', 386),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_자동화_설비', '자동화 설비', 387),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기_기기', '전기 기기', 388),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기_기기_설계', '전기 기기 설계', 389),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기_기기_유지_보수', '전기 기기 유지 보수', 390),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기_기기_제작', '전기 기기 제작', 391),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기_설비', '전기 설비', 392),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기_설비_운영', '전기 설비 운영', 393),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기_철도_시공', '전기 철도 시공', 394),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기_철도_시설물_유지_보수', '전기 철도 시설물 유지 보수', 395),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기_회로', '전기 회로', 396),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기·전자_기초', '전기·전자 기초', 397),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기·전자_측정', '전기·전자 측정', 398),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_부품_기획', '전자 부품 기획', 399),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_부품_소프트웨어_개발', '전자 부품 소프트웨어 개발', 400),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_응용_기기_기구_개발', '전자 응용 기기 기구 개발', 401),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_응용_기기_소프트웨어_개발', '전자 응용 기기 소프트웨어 개발', 402),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_응용_기기_하드웨어_개발', '전자 응용 기기 하드웨어 개발', 403),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_제품_기획', '전자 제품 기획', 404),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_제품_생산', '전자 제품 생산', 405),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_제품_설치·정비', '전자 제품 설치·정비', 406),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_제품_영업', '전자 제품 영업', 407),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전자_회로', '전자 회로', 408),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_정보_통신_기기_소프트웨어_개발', '정보 통신 기기 소프트웨어 개발', 409),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_정보_통신_기기_하드웨어_개발', '정보 통신 기기 하드웨어 개발', 410),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_직류_송배전_전력_변환_설비_제작', '직류 송배전 전력 변환 설비 제작', 411),
+    ('naesin', 'CURR_COMMON_ELECTRON_SELECT', 'NAESIN_전기·전자계열', '전기·전자계열', 412),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_건축_기초_실습', '건축 기초 실습', 413),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_건축_도면_해석과_제도', '건축 도면 해석과 제도', 414),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_건축_도장_시공', '건축 도장 시공', 415),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_건축_마감_시공', '건축 마감 시공', 416),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_건축_목공_시공', '건축 목공 시공', 417),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_건축_일반', '건축 일반', 418),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_경량_철골_시공', '경량 철골 시공', 419),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_공간_정보_구축', '공간 정보 구축', 420),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_공간_정보_융합_서비스', '공간 정보 융합 서비스', 421),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_공업_일반', '공업 일반', 422),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_기초_제도', '기초 제도', 423),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_단열·수장_시공', '단열·수장 시공', 424),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_조경', '조경', 425),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_조경_관리', '조경 관리', 426),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_조경_설계', '조경 설계', 427),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_조경_시공', '조경 시공', 428),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_지적', '지적', 429),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_창호_시공', '창호 시공', 430),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_철근_콘크리트_시공', '철근 콘크리트 시공', 431),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_측량', '측량', 432),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_토공·포장_시공', '토공·포장 시공', 433),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_토목_기초_실습', '토목 기초 실습', 434),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_토목_도면_해석과_제도', '토목 도면 해석과 제도', 435),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_토목_일반', '토목 일반', 436),
+    ('naesin', 'CURR_COMMON_CONSTRUCTION_SELECT', 'NAESIN_건설계열', '건설계열', 437),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_고객_관리', '고객 관리', 438),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_공급망_관리', '공급망 관리', 439),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_공정_관리', '공정 관리', 440),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_구매_조달', '구매 조달', 441),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_국제_상무', '국제 상무', 442),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_금융_일반', '금융 일반', 443),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_기업_자원_통합_관리', '기업 자원 통합 관리', 444),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_기업과_경영', '기업과 경영', 445),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_노무_관리', '노무 관리', 446),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_마케팅과_광고', '마케팅과 광고', 447),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_매장_판매', '매장 판매', 448),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_무역_금융_업무', '무역 금융 업무', 449),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_물류_관리', '물류 관리', 450),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_방문_판매', '방문 판매', 451),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_보험_모집', '보험 모집', 452),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_보험_일반', '보험 일반', 453),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_비서', '비서', 454),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_비즈니스_영어', '비즈니스 영어', 455),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_사무_관리', '사무 관리', 456),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_사무_행정', '사무 행정', 457),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_상업_경제', '상업 경제', 458),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_성공적인_직업생활', '성공적인 직업생활', 459),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_세무_실무', '세무 실무', 460),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_세무_일반', '세무 일반', 461),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_손해_사정', '손해 사정', 462),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_수출입_관리', '수출입 관리', 463),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_예산·자금', '예산·자금', 464),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_원산지_관리', '원산지 관리', 465),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_유통_관리', '유통 관리', 466),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_유통_일반', '유통 일반', 467),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_인사', '인사', 468),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_자재_관리', '자재 관리', 469),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_전자_상거래_실무', '전자 상거래 실무', 470),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_전자_상거래_일반', '전자 상거래 일반', 471),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_증권_거래_업무', '증권 거래 업무', 472),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_창구_사무', '창구 사무', 473),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_창업_일반', '창업 일반', 474),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_총무', '총무', 475),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_카드_영업', '카드 영업', 476),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_커뮤니케이션', '커뮤니케이션', 477),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_품질_관리', '품질 관리', 478),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_회계_실무', '회계 실무', 479),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_회계_원리', '회계 원리', 480),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_회계_정보_처리_시스템', '회계 정보 처리 시스템', 481),
+    ('naesin', 'CURR_COMMON_ECONOMY_SELECT', 'NAESIN_경영·금융계열', '경영·금융계열', 482),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_고급_물리학', '고급 물리학', 483),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_고급_생명과학', '고급 생명과학', 484),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_고급_수학Ⅰ', '고급 수학Ⅰ', 485),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_고급_수학Ⅱ', '고급 수학Ⅱ', 486),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_고급_지구과학', '고급 지구과학', 487),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_고급_화학', '고급 화학', 488),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_과학과제_연구', '과학과제 연구', 489),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_물리학_실험', '물리학 실험', 490),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_생명과학_실험', '생명과학 실험', 491),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_생태와_환경', '생태와 환경', 492),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_심화_수학Ⅰ', '심화 수학Ⅰ', 493),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_심화_수학Ⅱ', '심화 수학Ⅱ', 494),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_융합과학_탐구', '융합과학 탐구', 495),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_정보과학', '정보과학', 496),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_지구과학_실험', '지구과학 실험', 497),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_화학_실험', '화학 실험', 498),
+    ('naesin', 'CURR_COMMON_SCIENTIFIC_SELECT', 'NAESIN_과학계열', '과학계열', 499),
+    ('naesin', 'CURR_COMMON_CULTURE_SELECT', 'NAESIN_교육학', '교육학', 500),
+    ('naesin', 'CURR_COMMON_CULTURE_SELECT', 'NAESIN_논리학', '논리학', 501),
+    ('naesin', 'CURR_COMMON_CULTURE_SELECT', 'NAESIN_논술', '논술', 502),
+    ('naesin', 'CURR_COMMON_CULTURE_SELECT', 'NAESIN_보건', '보건', 503),
+    ('naesin', 'CURR_COMMON_CULTURE_SELECT', 'NAESIN_실용_경제', '실용 경제', 504),
+    ('naesin', 'CURR_COMMON_CULTURE_SELECT', 'NAESIN_심리학', '심리학', 505),
+    ('naesin', 'CURR_COMMON_CULTURE_SELECT', 'NAESIN_종교학', '종교학', 506),
+    ('naesin', 'CURR_COMMON_CULTURE_SELECT', 'NAESIN_진로와_직업', '진로와 직업', 507),
+    ('naesin', 'CURR_COMMON_CULTURE_SELECT', 'NAESIN_철학', '철학', 508),
+    ('naesin', 'CURR_COMMON_CULTURE_SELECT', 'NAESIN_환경', '환경', 509),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_국제_경제', '국제 경제', 510),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_국제_관계와_국제기구', '국제 관계와 국제기구', 511),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_국제_정치', '국제 정치', 512),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_국제법', '국제법', 513),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_비교_문화', '비교 문화', 514),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_사회_탐구_방법', '사회 탐구 방법', 515),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_사회과제_연구', '사회과제 연구', 516),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_세계_문제와_미래_사회', '세계 문제와 미래 사회', 517),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_지역_이해', '지역 이해', 518),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_한국_사회의_이해', '한국 사회의 이해', 519),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_현대_세계의_변화', '현대 세계의 변화', 520),
+    ('naesin', 'CURR_COMMON_INTERNATIONAL_SELECT', 'NAESIN_국제계열', '국제계열', 521),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_건설_광산_기계_설치·정비', '건설 광산 기계 설치·정비', 522),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_고무_플라스틱_기계_설치·정비', '고무 플라스틱 기계 설치·정비', 523),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_공작_기계_설치·정비', '공작 기계 설치·정비', 524),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_기계_기초_공작', '기계 기초 공작', 525),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_기계_소프트웨어_개발', '기계 소프트웨어 개발', 526),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_기계_수동_조립', '기계 수동 조립', 527),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_기계_일반', '기계 일반', 528),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_기계_제도', '기계 제도', 529),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_기계_제어_설계', '기계 제어 설계', 530),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_기계요소_설계', '기계요소 설계', 531),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_기장_생산', '기장 생산', 532),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_냉동_공조_설계', '냉동 공조 설계', 533),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_냉동_공조_유지·보수_관리', '냉동 공조 유지·보수 관리', 534),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_냉동_공조_일반', '냉동 공조 일반', 535),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_냉동_공조_장치_설치', '냉동 공조 장치 설치', 536),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_농업용_기계_설치·정비', '농업용 기계 설치·정비', 537),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_레이저_가공', '레이저 가공', 538),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_밀링_가공', '밀링 가공', 539),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_방전_가공', '방전 가공', 540),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_보일러_설치·정비', '보일러 설치·정비', 541),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_보일러_장치_설치', '보일러 장치 설치', 542),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_사출_금형_설계', '사출 금형 설계', 543),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_사출_금형_제작', '사출 금형 제작', 544),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_사출_금형_조립', '사출 금형 조립', 545),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_사출_금형_품질_관리', '사출 금형 품질 관리', 546),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선박_건조', '선박 건조', 547),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선박_구조', '선박 구조', 548),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선박_도장', '선박 도장', 549),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선박_이론', '선박 이론', 550),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선반_가공', '선반 가공', 551),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선실_의장_생산', '선실 의장 생산', 552),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선장_생산', '선장 생산', 553),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선체_가공', '선체 가공', 554),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선체_도면_독도와_제도', '선체 도면 독도와 제도', 555),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선체_생산_설계', '선체 생산 설계', 556),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선체_조립', '선체 조립', 557),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_선체_품질_관리', '선체 품질 관리', 558),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_섬유_기계_설치·정비', '섬유 기계 설치·정비', 559),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_성형_가공', '성형 가공', 560),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_소형_무인기_정비', '소형 무인기 정비', 561),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_승강기_설치·정비', '승강기 설치·정비', 562),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_연삭_가공', '연삭 가공', 563),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_오토바이_정비', '오토바이 정비', 564),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_운반_하역_기계_설치·정비', '운반 하역 기계 설치·정비', 565),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_워터제트_가공', '워터제트 가공', 566),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_유체_기계', '유체 기계', 567),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_기관', '자동차 기관', 568),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_도장', '자동차 도장', 569),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_섀시', '자동차 섀시', 570),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_섀시_정비', '자동차 섀시 정비', 571),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_엔진_정비', '자동차 엔진 정비', 572),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_영업', '자동차 영업', 573),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_일반', '자동차 일반', 574),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_전기·전자_장치_정비', '자동차 전기·전자 장치 정비', 575),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_전기·전자_제어', '자동차 전기·전자 제어', 576),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_정비_검사', '자동차 정비 검사', 577),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_차체_정비', '자동차 차체 정비', 578),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자동차_튜닝', '자동차 튜닝', 579),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_자전거_정비', '자전거 정비', 580),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_전자_기계_이론', '전자 기계 이론', 581),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_전장_생산', '전장 생산', 582),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_측정', '측정', 583),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_컴퓨터_활용_생산', '컴퓨터 활용 생산', 584),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_프레스_금형_설계', '프레스 금형 설계', 585),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_프레스_금형_제작', '프레스 금형 제작', 586),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_프레스_금형_조립', '프레스 금형 조립', 587),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_프레스_금형_품질_관리', '프레스 금형 품질 관리', 588),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_플라스마_가공', '플라스마 가공', 589),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_가스_터빈_엔진_정비', '항공기 가스 터빈 엔진 정비', 590),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_계통_정비', '항공기 계통 정비', 591),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_기체_정비', '항공기 기체 정비', 592),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_기체_제작', '항공기 기체 제작', 593),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_실무_기초', '항공기 실무 기초', 594),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_엔진·프로펠러_제작', '항공기 엔진·프로펠러 제작', 595),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_왕복_엔진_정비', '항공기 왕복 엔진 정비', 596),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_일반', '항공기 일반', 597),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_전기·전자_장비_정비', '항공기 전기·전자 장비 정비', 598),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_전기·전자_장비_제작', '항공기 전기·전자 장비 제작', 599),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_정비_관리', '항공기 정비 관리', 600),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_항공기_프로펠러_정비', '항공기 프로펠러 정비', 601),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_헬리콥터_정비', '헬리콥터 정비', 602),
+    ('naesin', 'CURR_COMMON_MECHANIC_SELECT', 'NAESIN_기계계열', '기계계열', 603),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_가금_사육', '가금 사육', 604),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_과수_재배', '과수 재배', 605),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_관광_농업', '관광 농업', 606),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_근해_어업', '근해 어업', 607),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_내수면_양식', '내수면 양식', 608),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_내수면_어업', '내수면 어업', 609),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농산_식품_가공', '농산 식품 가공', 610),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농산물_거래', '농산물 거래', 611),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농산물_유통', '농산물 유통', 612),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농산물_유통_관리', '농산물 유통 관리', 613),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농산물_품질_관리', '농산물 품질 관리', 614),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_경영', '농업 경영', 615),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_기계', '농업 기계', 616),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_기계_공작', '농업 기계 공작', 617),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_기계_운전･작업', '농업 기계 운전･작업', 618),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_기초_기술', '농업 기초 기술', 619),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_생산_관리', '농업 생산 관리', 620),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_생산_환경_조성', '농업 생산 환경 조성', 621),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_정보_관리', '농업 정보 관리', 622),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_토목_시공･측량', '농업 토목 시공･측량', 623),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_토목_제도･설계', '농업 토목 제도･설계', 624),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업과_물', '농업과 물', 625),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농촌_체험_상품_개발', '농촌 체험 상품 개발', 626),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농촌_체험_시설_운영', '농촌 체험 시설 운영', 627),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농촌과_농지_개발', '농촌과 농지 개발', 628),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_동물_약품_제조', '동물 약품 제조', 629),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_동물_자원', '동물 자원', 630),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_돼지_사육', '돼지 사육', 631),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_말_사육', '말 사육', 632),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_목재_가공', '목재 가공', 633),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_바이오_의약품_제조', '바이오 의약품 제조', 634),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_반려동물_관리', '반려동물 관리', 635),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_버섯_재배', '버섯 재배', 636),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_사료_생산', '사료 생산', 637),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_산림_보호', '산림 보호', 638),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_산림_자원', '산림 자원', 639),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_산림_조성', '산림 조성', 640),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_산림_휴양', '산림 휴양', 641),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_산업_잠수', '산업 잠수', 642),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_생명_공학_기술', '생명 공학 기술', 643),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_생산_자재', '생산 자재', 644),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수도작_재배', '수도작 재배', 645),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수산_경영', '수산 경영', 646),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수산_생물', '수산 생물', 647),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수산_생물_질병_관리', '수산 생물 질병 관리', 648),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수산_양식_일반', '수산 양식 일반', 649),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수산_일반', '수산 일반', 650),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수산_종묘_생산', '수산 종묘 생산', 651),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수산물_유통', '수산물 유통', 652),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수산･해운_산업_기초', '수산･해운 산업 기초', 653),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수상_레저_기구_조정', '수상 레저 기구 조정', 654),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_수의_보조', '수의 보조', 655),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_실험_동물과_기타_가축', '실험 동물과 기타 가축', 656),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_애완동물_미용', '애완동물 미용', 657),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_양식_생물_질병', '양식 생물 질병', 658),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_어업_자원_관리', '어업 자원 관리', 659),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_어업_환경_개선', '어업 환경 개선', 660),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_어촌_체험_상품_개발', '어촌 체험 상품 개발', 661),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_어촌_체험_시설_운영', '어촌 체험 시설 운영', 662),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_연안_어업', '연안 어업', 663),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_염_생산', '염 생산', 664),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_요트_조종', '요트 조종', 665),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_원양_어업', '원양 어업', 666),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_원예', '원예', 667),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_육종', '육종', 668),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_일반_잠수', '일반 잠수', 669),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_임산_가공', '임산 가공', 670),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_임산물_생산', '임산물 생산', 671),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_임업_종묘', '임업 종묘', 672),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_잠수_기술', '잠수 기술', 673),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_재배', '재배', 674),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_전자_통신_기초', '전자 통신 기초', 675),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_전자_통신_운용', '전자 통신 운용', 676),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_전특작_재배', '전특작 재배', 677),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_젖소_사육', '젖소 사육', 678),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_조경_식물_관리', '조경 식물 관리', 679),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_종자_생산', '종자 생산', 680),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_종자_유통_보급', '종자 유통 보급', 681),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_종축', '종축', 682),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_채소_재배', '채소 재배', 683),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_친환경_농업', '친환경 농업', 684),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_펄프･종이_제조', '펄프･종이 제조', 685),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_한우_사육', '한우 사육', 686),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_해면_양식', '해면 양식', 687),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_해양', '해양', 688),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_해양_레저_관광', '해양 레저 관광', 689),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_해양_생산_일반', '해양 생산 일반', 690),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_해양_오염･방제', '해양 오염･방제', 691),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_해양_정보_관리', '해양 정보 관리', 692),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_해양_환경과_자원', '해양 환경과 자원', 693),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_해양의_이해', '해양의 이해', 694),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_화훼_장식', '화훼 장식', 695),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_화훼_장식_기초', '화훼 장식 기초', 696),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_화훼_재배', '화훼 재배', 697),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_환경_보전', '환경 보전', 698),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농업_이해', '농업 이해', 699),
+    ('naesin', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', 'NAESIN_농림·수산계열', '농림·수산계열', 700),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_간호의_기초', '간호의 기초', 701),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_공중_보건', '공중 보건', 702),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_기초_간호_임상_실무', '기초 간호 임상 실무', 703),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_대인_복지_서비스', '대인 복지 서비스', 704),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_보건_간호', '보건 간호', 705),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_보육_과정', '보육 과정', 706),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_보육_실습', '보육 실습', 707),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_보육_원리와_보육_교사', '보육 원리와 보육 교사', 708),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_복지_서비스의_기초', '복지 서비스의 기초', 709),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_사회_복지_시설_실무', '사회 복지 시설 실무', 710),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_사회_복지_시설의_이해', '사회 복지 시설의 이해', 711),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_생활_서비스_산업의_이해', '생활 서비스 산업의 이해', 712),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_아동_복지', '아동 복지', 713),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_아동_생활_지도', '아동 생활 지도', 714),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_영·유아_건강·안전·영양_지도', '영·유아 건강·안전·영양 지도', 715),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_영·유아_교수_방법', '영·유아 교수 방법', 716),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_영·유아_놀이_지도', '영·유아 놀이 지도', 717),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_인간_발달', '인간 발달', 718),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_인체_구조와_기능', '인체 구조와 기능', 719),
+    ('naesin', 'CURR_COMMON_HEALTH_WELFARE_SELECT', 'NAESIN_보건·복지계열', '보건·복지계열', 720),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_기관_실무_기초', '기관 실무 기초', 721),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_기관_직무_일반', '기관 직무 일반', 722),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_기관사_직무', '기관사 직무', 723),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_갑판_관리', '선박 갑판 관리', 724),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_기관_정비', '선박 기관 정비', 725),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_기기_운용', '선박 기기 운용', 726),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_보조_기계', '선박 보조 기계', 727),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_보조기계_정비', '선박 보조기계 정비', 728),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_안전_관리', '선박 안전 관리', 729),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_운용', '선박 운용', 730),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_운항_관리', '선박 운항 관리', 731),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_전기･전자', '선박 전기･전자', 732),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_통신', '선박 통신', 733),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선화_운송', '선화 운송', 734),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_열기관', '열기관', 735),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_항만_물류_일반', '항만 물류 일반', 736),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_항해_기초', '항해 기초', 737),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_항해사_직무', '항해사 직무', 738),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_해사_법규', '해사 법규', 739),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_해사_영어', '해사 영어', 740),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_해사_일반', '해사 일반', 741),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_해운_일반', '해운 일반', 742),
+    ('naesin', 'CURR_COMMON_SHIP_OPERATION_SELECT', 'NAESIN_선박_운항계열', '선박 운항계열', 743),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_가죽·모피_디자인과_생산', '가죽·모피 디자인과 생산', 744),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_구매_생산_관리', '구매 생산 관리', 745),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_니트_의류_생산', '니트 의류 생산', 746),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_방사·사가공', '방사·사가공', 747),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_방적', '방적', 748),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_비주얼_머천다이징', '비주얼 머천다이징', 749),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_생산_현장_관리', '생산 현장 관리', 750),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_서양_의복_구성과_생산', '서양 의복 구성과 생산', 751),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_섬유_공정', '섬유 공정', 752),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_섬유_재료', '섬유 재료', 753),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_염색·가공', '염색·가공', 754),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_염색·가공_기초', '염색·가공 기초', 755),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_의류_재료_관리', '의류 재료 관리', 756),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_의복_구성의_기초', '의복 구성의 기초', 757),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_제포', '제포', 758),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_텍스타일_디자인', '텍스타일 디자인', 759),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_패션_디자인의_기초', '패션 디자인의 기초', 760),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_패션_디자인의_실제', '패션 디자인의 실제', 761),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_패션_마케팅', '패션 마케팅', 762),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_패션_상품_유통_관리', '패션 상품 유통 관리', 763),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_패션_소품_디자인과_생산', '패션 소품 디자인과 생산', 764),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_패턴_메이킹', '패턴 메이킹', 765),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_한국_의복_구성과_생산', '한국 의복 구성과 생산', 766),
+    ('naesin', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', 'NAESIN_섬유·의류계열', '섬유·의류계열', 767),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_고전문학_감상', '고전문학 감상', 768),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_공연실습', '공연실습', 769),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_극_창작', '극 창작', 770),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_기초_촬영', '기초 촬영', 771),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_드로잉', '드로잉', 772),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_매체_미술', '매체 미술', 773),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_무대기술', '무대기술', 774),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_무용_감상과_비평', '무용 감상과 비평', 775),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_무용_기초_실기', '무용 기초 실기', 776),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_무용_음악_실습', '무용 음악 실습', 777),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_무용_전공_실기', '무용 전공 실기', 778),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_무용과_매체', '무용과 매체', 779),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_무용과_몸', '무용과 몸', 780),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_무용의_이해', '무용의 이해', 781),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_문예_창작_입문', '문예 창작 입문', 782),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_문장론', '문장론', 783),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_문학_개론', '문학 개론', 784),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_문학과_매체', '문학과 매체', 785),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_미술_이론', '미술 이론', 786),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_미술_전공_실기', '미술 전공 실기', 787),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_미술사', '미술사', 788),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_사진_감상과_비평', '사진 감상과 비평', 789),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_사진_영상_편집', '사진 영상 편집', 790),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_사진_표현_기법', '사진 표현 기법', 791),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_사진의_이해', '사진의 이해', 792),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_소설_창작', '소설 창작', 793),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_시_창작', '시 창작', 794),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_시나리오', '시나리오', 795),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_시창·청음', '시창·청음', 796),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_안무', '안무', 797),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_암실_실기', '암실 실기', 798),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_연극_감상과_비평', '연극 감상과 비평', 799),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_연극_제작_실습', '연극 제작 실습', 800),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_연극의_이해', '연극의 이해', 801),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_연기', '연기', 802),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_영상_제작의_이해', '영상 제작의 이해', 803),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_영화_감상과_비평', '영화 감상과 비평', 804),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_영화_제작_실습', '영화 제작 실습', 805),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_영화기술', '영화기술', 806),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_영화의_이해', '영화의 이해', 807),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_음악_전공_실기', '음악 전공 실기', 808),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_음악사', '음악사', 809),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_음악이론', '음악이론', 810),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_입체_조형', '입체 조형', 811),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_중급_촬영', '중급 촬영', 812),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_평면_조형', '평면 조형', 813),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_합주', '합주', 814),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_합창', '합창', 815),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_현대문학_감상', '현대문학 감상', 816),
+    ('naesin', 'CURR_COMMON_ARTS_SELECT', 'NAESIN_예술계열', '예술계열', 817),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_독일어_독해와_작문Ⅰ', '독일어 독해와 작문Ⅰ', 818),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_독일어_독해와_작문Ⅱ', '독일어 독해와 작문Ⅱ', 819),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_독일어_회화Ⅰ', '독일어 회화Ⅰ', 820),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_독일어_회화Ⅱ', '독일어 회화Ⅱ', 821),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_독일어권_문화', '독일어권 문화', 822),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_러시아_문화', '러시아 문화', 823),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_러시아어_독해와_작문Ⅰ', '러시아어 독해와 작문Ⅰ', 824),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_러시아어_독해와_작문Ⅱ', '러시아어 독해와 작문Ⅱ', 825),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_러시아어_회화Ⅰ', '러시아어 회화Ⅰ', 826),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_러시아어_회화Ⅱ', '러시아어 회화Ⅱ', 827),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_베트남_문화', '베트남 문화', 828),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_베트남어_독해와_작문Ⅰ', '베트남어 독해와 작문Ⅰ', 829),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_베트남어_독해와_작문Ⅱ', '베트남어 독해와 작문Ⅱ', 830),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_베트남어_회화Ⅰ', '베트남어 회화Ⅰ', 831),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_베트남어_회화Ⅱ', '베트남어 회화Ⅱ', 832),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_스페인어_독해와_작문Ⅰ', '스페인어 독해와 작문Ⅰ', 833),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_스페인어_독해와_작문Ⅱ', '스페인어 독해와 작문Ⅱ', 834),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_스페인어_회화Ⅰ', '스페인어 회화Ⅰ', 835),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_스페인어_회화Ⅱ', '스페인어 회화Ⅱ', 836),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_스페인어권_문화', '스페인어권 문화', 837),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_심화_영어_독해Ⅰ', '심화 영어 독해Ⅰ', 838),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_심화_영어_독해Ⅱ', '심화 영어 독해Ⅱ', 839),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_심화_영어_작문Ⅰ', '심화 영어 작문Ⅰ', 840),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_심화_영어_작문Ⅱ', '심화 영어 작문Ⅱ', 841),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_심화_영어_회화Ⅰ', '심화 영어 회화Ⅰ', 842),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_심화_영어_회화Ⅱ', '심화 영어 회화Ⅱ', 843),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_심화_영어Ⅰ', '심화 영어Ⅰ', 844),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_심화_영어Ⅱ', '심화 영어Ⅱ', 845),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_아랍_문화', '아랍 문화', 846),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_아랍어_독해와_작문Ⅰ', '아랍어 독해와 작문Ⅰ', 847),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_아랍어_독해와_작문Ⅱ', '아랍어 독해와 작문Ⅱ', 848),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_아랍어_회화Ⅰ', '아랍어 회화Ⅰ', 849),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_아랍어_회화Ⅱ', '아랍어 회화Ⅱ', 850),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_일본_문화', '일본 문화', 851),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_일본어_독해와_작문Ⅰ', '일본어 독해와 작문Ⅰ', 852),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_일본어_독해와_작문Ⅱ', '일본어 독해와 작문Ⅱ', 853),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_일본어_회화Ⅰ', '일본어 회화Ⅰ', 854),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_일본어_회화Ⅱ', '일본어 회화Ⅱ', 855),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_전공_기초_독일어', '전공 기초 독일어', 856),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_전공_기초_러시아어', '전공 기초 러시아어', 857),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_전공_기초_베트남어', '전공 기초 베트남어', 858),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_전공_기초_스페인어', '전공 기초 스페인어', 859),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_전공_기초_아랍어', '전공 기초 아랍어', 860),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_전공_기초_일본어', '전공 기초 일본어', 861),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_전공_기초_중국어', '전공 기초 중국어', 862),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_전공_기초_프랑스어', '전공 기초 프랑스어', 863),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_중국_문화', '중국 문화', 864),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_중국어_독해와_작문Ⅰ', '중국어 독해와 작문Ⅰ', 865),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_중국어_독해와_작문Ⅱ', '중국어 독해와 작문Ⅱ', 866),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_중국어_회화Ⅰ', '중국어 회화Ⅰ', 867),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_중국어_회화Ⅱ', '중국어 회화Ⅱ', 868),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_프랑스어_독해와_작문Ⅰ', '프랑스어 독해와 작문Ⅰ', 869),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_프랑스어_독해와_작문Ⅱ', '프랑스어 독해와 작문Ⅱ', 870),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_프랑스어_회화Ⅰ', '프랑스어 회화Ⅰ', 871),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_프랑스어_회화Ⅱ', '프랑스어 회화Ⅱ', 872),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_프랑스어권_문화', '프랑스어권 문화', 873),
+    ('naesin', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', 'NAESIN_외국어_계열', '외국어 계열', 874),
+    ('naesin', 'CURR_COMMON_FOOD_COOKING_SELECT', 'NAESIN_급식_관리', '급식 관리', 875),
+    ('naesin', 'CURR_COMMON_FOOD_COOKING_SELECT', 'NAESIN_바리스타', '바리스타', 876),
+    ('naesin', 'CURR_COMMON_FOOD_COOKING_SELECT', 'NAESIN_바텐더', '바텐더', 877),
+    ('naesin', 'CURR_COMMON_FOOD_COOKING_SELECT', 'NAESIN_서양_조리', '서양 조리', 878),
+    ('naesin', 'CURR_COMMON_FOOD_COOKING_SELECT', 'NAESIN_소믈리에', '소믈리에', 879),
+    ('naesin', 'CURR_COMMON_FOOD_COOKING_SELECT', 'NAESIN_식품과_영양', '식품과 영양', 880),
+    ('naesin', 'CURR_COMMON_FOOD_COOKING_SELECT', 'NAESIN_일식_조리', '일식 조리', 881),
+    ('naesin', 'CURR_COMMON_FOOD_COOKING_SELECT', 'NAESIN_중식_조리', '중식 조리', 882),
+    ('naesin', 'CURR_COMMON_FOOD_COOKING_SELECT', 'NAESIN_한국_조리', '한국 조리', 883),
+    ('naesin', 'CURR_COMMON_FOOD_COOKING_SELECT', 'NAESIN_음식_조리계열', '음식 조리계열', 884),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_공예_일반', '공예 일반', 885),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_공예_재료와_도구', '공예 재료와 도구', 886),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_귀금속·보석_디자인', '귀금속·보석 디자인', 887),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_금속_공예', '금속 공예', 888),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_도자기_공예', '도자기 공예', 889),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_디지털_이미지_재현', '디지털 이미지 재현', 890),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_목공예', '목공예', 891),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_보석_감정', '보석 감정', 892),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_석공예', '석공예', 893),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_섬유_공예', '섬유 공예', 894),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_인쇄_일반', '인쇄 일반', 895),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_출판_일반', '출판 일반', 896),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_출판_편집', '출판 편집', 897),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_특수_인쇄', '특수 인쇄', 898),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_평판_인쇄', '평판 인쇄', 899),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_프리프레스', '프리프레스', 900),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_후가공', '후가공', 901),
+    ('naesin', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', 'NAESIN_인쇄·출판·공예계열', '인쇄·출판·공예계열', 902),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_가스_텅스텐_아크_용접', '가스 텅스텐 아크 용접', 903),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_광학_재료', '광학 재료', 904),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_금속_열처리', '금속 열처리', 905),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_금속_재료_가공', '금속 재료 가공', 906),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_금속_재료_신뢰성_시험', '금속 재료 신뢰성 시험', 907),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_내열_구조_재료', '내열 구조 재료', 908),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_내화물', '내화물', 909),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_도금', '도금', 910),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_도자기', '도자기', 911),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_로봇_용접', '로봇 용접', 912),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_배관_시공', '배관 시공', 913),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_비철_금속_제련', '비철 금속 제련', 914),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_산업_설비', '산업 설비', 915),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_생체_세라믹_재료', '생체 세라믹 재료', 916),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_서브머지드_아크_용접', '서브머지드 아크 용접', 917),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_세라믹_원리·공정', '세라믹 원리·공정', 918),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_세라믹_재료', '세라믹 재료', 919),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_시멘트', '시멘트', 920),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_압연', '압연', 921),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_연삭재', '연삭재', 922),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_유리·법랑', '유리·법랑', 923),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_이산화탄소·가스_메탈_아크_용접', '이산화탄소·가스 메탈 아크 용접', 924),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_재료_시험', '재료 시험', 925),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_재료_일반', '재료 일반', 926),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_전기·전자_재료', '전기·전자 재료', 927),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_제강', '제강', 928),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_제선', '제선', 929),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_주조', '주조', 930),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_탄소_제품', '탄소 제품', 931),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_판금_제관', '판금 제관', 932),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_피복_아크_용접', '피복 아크 용접', 933),
+    ('naesin', 'CURR_COMMON_MATERIALS_SELECT', 'NAESIN_재료계열', '재료계열', 934),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_네트워크_구축', '네트워크 구축', 935),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_네트워크_프로그래밍', '네트워크 프로그래밍', 936),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_데이터베이스_프로그래밍', '데이터베이스 프로그래밍', 937),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_무선_통신_구축', '무선 통신 구축', 938),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_방송_일반', '방송 일반', 939),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_방송_제작_시스템_운용', '방송 제작 시스템 운용', 940),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_빅데이터_분석', '빅데이터 분석', 941),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_사물_인터넷_서비스_기획', '사물 인터넷 서비스 기획', 942),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_시스템_관리_및_지원', '시스템 관리 및 지원', 943),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_시스템_프로그래밍', '시스템 프로그래밍', 944),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_유선_통신_구축', '유선 통신 구축', 945),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_응용_프로그래밍_개발', '응용 프로그래밍 개발', 946),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_응용_프로그래밍_화면_구현', '응용 프로그래밍 화면 구현', 947),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_자료_구조', '자료 구조', 948),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_정보_보호_관리', '정보 보호 관리', 949),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_정보_처리와_관리', '정보 처리와 관리', 950),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_정보_통신', '정보 통신', 951),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_초고속망_서비스_관리_운용', '초고속망 서비스 관리 운용', 952),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_컴퓨터_구조', '컴퓨터 구조', 953),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_컴퓨터_네트워크', '컴퓨터 네트워크', 954),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_컴퓨터_보안', '컴퓨터 보안', 955),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_컴퓨터_시스템_일반', '컴퓨터 시스템 일반', 956),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_통신_시스템', '통신 시스템', 957),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_통신_일반', '통신 일반', 958),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_프로그래밍', '프로그래밍', 959),
+    ('naesin', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', 'NAESIN_정보·통신계열', '정보·통신계열', 960),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_독일어Ⅰ', '독일어Ⅰ', 961),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_독일어Ⅱ', '독일어Ⅱ', 962),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_러시아어Ⅰ', '러시아어Ⅰ', 963),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_러시아어Ⅱ', '러시아어Ⅱ', 964),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_베트남어Ⅰ', '베트남어Ⅰ', 965),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_베트남어Ⅱ', '베트남어Ⅱ', 966),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_스페인어Ⅰ', '스페인어Ⅰ', 967),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_스페인어Ⅱ', '스페인어Ⅱ', 968),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_아랍어Ⅰ', '아랍어Ⅰ', 969),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_아랍어Ⅱ', '아랍어Ⅱ', 970),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_일본어Ⅰ', '일본어Ⅰ', 971),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_일본어Ⅱ', '일본어Ⅱ', 972),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_중국어Ⅰ', '중국어Ⅰ', 973),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_중국어Ⅱ', '중국어Ⅱ', 974),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_프랑스어Ⅰ', '프랑스어Ⅰ', 975),
+    ('naesin', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', 'NAESIN_프랑스어Ⅱ', '프랑스어Ⅱ', 976),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_개인·대인_운동', '개인·대인 운동', 977),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_단체_운동', '단체 운동', 978),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_수상_운동', '수상 운동', 979),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_스포츠_개론', '스포츠 개론', 980),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_스포츠_경기_분석', '스포츠 경기 분석', 981),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_스포츠_경기_실습', '스포츠 경기 실습', 982),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_스포츠_경기_체력', '스포츠 경기 체력', 983),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_육상_운동', '육상 운동', 984),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_체육_전공_실기_기초', '체육 전공 실기 기초', 985),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_체육_전공_실기_심화', '체육 전공 실기 심화', 986),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_체육_전공_실기_응용', '체육 전공 실기 응용', 987),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_체육_지도법', '체육 지도법', 988),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_체육과_진로_탐구', '체육과 진로 탐구', 989),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_체조_운동', '체조 운동', 990),
+    ('naesin', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', 'NAESIN_체육계열', '체육계열', 991),
+    ('naesin', 'CURR_COMMON_KOREAN_HISTORY_SELECT', 'NAESIN_한국사', '한국사', 992),
+    ('naesin', 'CURR_COMMON_CLASSICAL_CHINESE_SELECT', 'NAESIN_한문Ⅰ', '한문Ⅰ', 993),
+    ('naesin', 'CURR_COMMON_CLASSICAL_CHINESE_SELECT', 'NAESIN_한문Ⅱ', '한문Ⅱ', 994),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_고분자_제품_제조', '고분자 제품 제조', 995),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_공업_화학', '공업 화학', 996),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_기능성_정밀_화학_제품_제조', '기능성 정밀 화학 제품 제조', 997),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_단위_조작', '단위 조작', 998),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_무기_공업_화학', '무기 공업 화학', 999),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_바이오_화학_제품_제조', '바이오 화학 제품 제조', 1000),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_석유_화학_제품', '석유 화학 제품', 1001),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_제조_화학', '제조 화학', 1002),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_플라스틱_제품_제조', '플라스틱 제품 제조', 1003),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_화학_공정_유지_운영', '화학 공정 유지 운영', 1004),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_화학_물질_관리', '화학 물질 관리', 1005),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_화학_분석', '화학 분석', 1006),
+    ('naesin', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', 'NAESIN_화학_공업계열', '화학 공업계열', 1007),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_가스_안전_관리', '가스 안전 관리', 1008),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_건설_안전_관리', '건설 안전 관리', 1009),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_기계_안전_관리', '기계 안전 관리', 1010),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_대기_관리', '대기 관리', 1011),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_산업_안전_보건_기초', '산업 안전 보건 기초', 1012),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_산업_환경_보건', '산업 환경 보건', 1013),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_소음_진동_측정', '소음 진동 측정', 1014),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_수질_관리', '수질 관리', 1015),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_인간과_환경', '인간과 환경', 1016),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_전기_안전_관리', '전기 안전 관리', 1017),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_폐기물_관리', '폐기물 관리', 1018),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_화공_안전_관리', '화공 안전 관리', 1019),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_환경_생태_관리', '환경 생태 관리', 1020),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_환경_화학_기초', '환경 화학 기초', 1021),
+    ('naesin', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', 'NAESIN_환경·안전계열', '환경·안전계열', 1022),
+    ('suneung', '국어', 'SUNEUNG_화법과_작문', '화법과 작문', 1023),
+    ('suneung', '국어', 'SUNEUNG_언어와_매체', '언어와 매체', 1024),
+    ('suneung', '수학', 'SUNEUNG_확률과_통계', '확률과 통계', 1025),
+    ('suneung', '수학', 'SUNEUNG_미적분', '미적분', 1026),
+    ('suneung', '수학', 'SUNEUNG_기하', '기하', 1027),
+    ('suneung', '사회탐구', 'SUNEUNG_생활과_윤리', '생활과 윤리', 1028),
+    ('suneung', '사회탐구', 'SUNEUNG_윤리와_사상', '윤리와 사상', 1029),
+    ('suneung', '사회탐구', 'SUNEUNG_한국지리', '한국지리', 1030),
+    ('suneung', '사회탐구', 'SUNEUNG_세계지리', '세계지리', 1031),
+    ('suneung', '사회탐구', 'SUNEUNG_동아시아사', '동아시아사', 1032),
+    ('suneung', '사회탐구', 'SUNEUNG_세계사', '세계사', 1033),
+    ('suneung', '사회탐구', 'SUNEUNG_정치와_법', '정치와 법', 1034),
+    ('suneung', '사회탐구', 'SUNEUNG_경제', '경제', 1035),
+    ('suneung', '사회탐구', 'SUNEUNG_사회·문화', '사회·문화', 1036),
+    ('suneung', '과학탐구', 'SUNEUNG_물리학Ⅰ', '물리학Ⅰ', 1037),
+    ('suneung', '과학탐구', 'SUNEUNG_화학Ⅰ', '화학Ⅰ', 1038),
+    ('suneung', '과학탐구', 'SUNEUNG_생명과학Ⅰ', '생명과학Ⅰ', 1039),
+    ('suneung', '과학탐구', 'SUNEUNG_지구과학Ⅰ', '지구과학Ⅰ', 1040),
+    ('suneung', '과학탐구', 'SUNEUNG_물리학Ⅱ', '물리학Ⅱ', 1041),
+    ('suneung', '과학탐구', 'SUNEUNG_화학Ⅱ', '화학Ⅱ', 1042),
+    ('suneung', '과학탐구', 'SUNEUNG_생명과학Ⅱ', '생명과학Ⅱ', 1043),
+    ('suneung', '과학탐구', 'SUNEUNG_지구과학Ⅱ', '지구과학Ⅱ', 1044);
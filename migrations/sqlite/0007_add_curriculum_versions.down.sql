@@ -0,0 +1,15 @@
+-- 2022 개정 과목과 교육과정 버전 컬럼을 제거합니다.
+DELETE FROM subjects_master WHERE curriculum_version = '2022';
+DELETE FROM subject_curriculums WHERE curriculum_version = '2022';
+DELETE FROM subject_classifications WHERE curriculum_version = '2022';
+DELETE FROM subject_catalog_versions WHERE version = '2022-seed';
+DROP INDEX IF EXISTS idx_subjects_master_curriculum_version;
+ALTER TABLE subject_classifications DROP COLUMN admission_year_to;
+ALTER TABLE subject_classifications DROP COLUMN admission_year_from;
+ALTER TABLE subject_classifications DROP COLUMN curriculum_version;
+ALTER TABLE subject_curriculums DROP COLUMN admission_year_to;
+ALTER TABLE subject_curriculums DROP COLUMN admission_year_from;
+ALTER TABLE subject_curriculums DROP COLUMN curriculum_version;
+ALTER TABLE subjects_master DROP COLUMN admission_year_to;
+ALTER TABLE subjects_master DROP COLUMN admission_year_from;
+ALTER TABLE subjects_master DROP COLUMN curriculum_version;
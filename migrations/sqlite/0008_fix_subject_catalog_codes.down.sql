@@ -0,0 +1,47 @@
+-- 코드 정리 전으로 되돌립니다. 진로선택 교과는 다시 일반선택 교과 코드를 씁니다.
+-- 마이그레이션의 초기 목록만 되돌립니다. `univ import-subjects` 로 적재한 목록이 있으면 과목/교과는 그대로 둡니다.
+DELETE FROM subject_catalog_versions WHERE version = '2015-codes-fix';
+UPDATE subjects_master SET parent_code = 'CURR_COMMON_' || substr(parent_code, 13) WHERE parent_code LIKE 'CURR_CAREER_%'
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed', '2015-codes-fix'));
+DELETE FROM subject_curriculums WHERE classification_code = 'CLASS_CAREER_SELECT'
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed', '2015-codes-fix'));
+INSERT OR IGNORE INTO subject_curriculums (classification_code, code, name, display_order, curriculum_version, admission_year_from, admission_year_to)
+SELECT * FROM (VALUES
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_KOR_SELECT', '국어', 37, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_MATH_SELECT', '수학', 38, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ENG_SELECT', '영어', 39, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_SOCIETY_SELECT', '사회(역사/도덕포함)', 40, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_SCIENCE_SELECT', '과학', 41, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_PE_SELECT', '체육', 42, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ART_SELECT', '예술', 43, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_INDUSTRY_SELECT', '공업', 44, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_OCEAN_SELECT', '수산·해운', 45, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_DESIGN_SELECT', '디자인·문화콘텐츠', 46, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_LEISURE_SELECT', '미용·관광·레저', 47, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_FOOD_SELECT', '식품·가공', 48, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ELECTRON_SELECT', '전기·전자', 49, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_CONSTRUCTION_SELECT', '건설', 50, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ECONOMY_SELECT', '경영·금융', 51, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_SCIENTIFIC_SELECT', '과학 계열', 52, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_CULTURE_SELECT', '교양', 53, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_INTERNATIONAL_SELECT', '국제 계열', 54, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_MECHANIC_SELECT', '기계', 55, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_AGRICULTURE_FISHERIES_OCEAN_SELECT', '농림·수산해양', 57, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_HEALTH_WELFARE_SELECT', '보건·복지', 58, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_SHIP_OPERATION_SELECT', '선박 운항', 59, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_TEXTILE_CLOTHING_SELECT', '섬유·의류', 60, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ARTS_SELECT', '예술 계열', 61, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_FOREIGN_LANGUAGE_SELECT', '외국어 계열', 62, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_FOOD_COOKING_SELECT', '음식 조리', 63, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_PRINTING_PUBLISHING_CRAFT_SELECT', '인쇄·출판·공예', 64, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_MATERIALS_SELECT', '재료', 65, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_INFORMATION_COMMUNICATION_SELECT', '정보·통신', 66, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_SECOND_FOREIGN_LANGUAGE_SELECT', '제2외국어', 67, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_PHYSICAL_EDUCATION_SELECT', '체육 계열', 68, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_KOREAN_HISTORY_SELECT', '한국사', 69, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_CLASSICAL_CHINESE_SELECT', '한문', 70, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_CHEMICAL_INDUSTRY_SELECT', '화학공업', 71, '2015', 2021, 2027),
+    ('CLASS_CAREER_SELECT', 'CURR_COMMON_ENVIRONMENT_SAFETY_SELECT', '환경·안전', 72, '2015', 2021, 2027)
+) AS v WHERE NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed', '2015-codes-fix'));
+UPDATE subjects_master SET code = 'NAESIN_' || replace(name, ' ', '_') WHERE subject_type = 'naesin' AND curriculum_version <> '2022'
+    AND NOT EXISTS (SELECT 1 FROM subject_catalog_versions WHERE version NOT IN ('2015-seed', '2022-seed', '2015-codes-fix'));
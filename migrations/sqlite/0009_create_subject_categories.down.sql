@@ -0,0 +1,2 @@
+DELETE FROM subject_catalog_versions WHERE version = 'categories-seed';
+DROP TABLE IF EXISTS subject_categories;
@@ -0,0 +1,35 @@
+-- 교과 분류(국영수사과, 전 과목 등). 대학 계산 스키마의 FILTER_SUBJECTS_BY_CATEGORY 등이 이 이름으로 과목을 고릅니다. (handlers/subject_category.go)
+-- university_id 가 '' 인 행은 모든 대학의 기본값이고, 대학마다 다르게 묶는 분류는 같은 label 로 그 대학의 행을 추가합니다.
+-- rule_json: {"all", "includes"(다른 분류), "curriculums"(교과 이름), "classifications"(교과구분종류 이름), "subjects"/"excludeSubjects"(과목 코드)}
+CREATE TABLE IF NOT EXISTS subject_categories (
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    university_id TEXT NOT NULL DEFAULT '',
+    label         TEXT NOT NULL,
+    display_order INTEGER NOT NULL DEFAULT 0,
+    rule_json     TEXT NOT NULL,
+    UNIQUE (university_id, label)
+);
+
+-- 기본 분류. 2015 개정의 한국사는 별도 교과이고 2022 개정의 한국사1/2 는 사회 교과에 있으므로, 둘 다 "한국사" 로 모으고 "사회" 에서는 뺍니다.
+INSERT OR IGNORE INTO subject_categories (university_id, label, display_order, rule_json) VALUES
+    ('', '국어', 1, '{"curriculums": ["국어"]}'),
+    ('', '수학', 2, '{"curriculums": ["수학"]}'),
+    ('', '영어', 3, '{"curriculums": ["영어"]}'),
+    ('', '사회', 4, '{"curriculums": ["사회(역사/도덕포함)"], "excludeSubjects": ["NAESIN_한국사", "NAESIN_KOREAN_HISTORY_한국사", "NAESIN_2022_한국사1", "NAESIN_2022_한국사2"]}'),
+    ('', '과학', 5, '{"curriculums": ["과학"]}'),
+    ('', '한국사', 6, '{"curriculums": ["한국사"], "subjects": ["NAESIN_한국사", "NAESIN_KOREAN_HISTORY_한국사", "NAESIN_2022_한국사1", "NAESIN_2022_한국사2"]}'),
+    ('', '국영수', 7, '{"includes": ["국어", "수학", "영어"]}'),
+    ('', '국영수사', 8, '{"includes": ["국어", "수학", "영어", "사회"]}'),
+    ('', '국영수과', 9, '{"includes": ["국어", "수학", "영어", "과학"]}'),
+    ('', '국영수사과', 10, '{"includes": ["국어", "수학", "영어", "사회", "과학"]}'),
+    ('', '국영수한사과', 11, '{"includes": ["국어", "수학", "영어", "한국사", "사회", "과학"]}'),
+    ('', '국영수+탐구', 12, '{"includes": ["국어", "수학", "영어", "사회", "과학"]}'),
+    ('', '체육·예술', 13, '{"curriculums": ["체육", "예술"]}'),
+    ('', '공통', 14, '{"classifications": ["공통"]}'),
+    ('', '일반선택', 15, '{"classifications": ["일반선택"]}'),
+    ('', '진로선택', 16, '{"classifications": ["진로선택"]}'),
+    ('', '융합선택', 17, '{"classifications": ["융합선택"]}'),
+    ('', '전 과목', 18, '{"all": true}');
+
+INSERT OR IGNORE INTO subject_catalog_versions (version, note) VALUES
+    ('categories-seed', '교과 분류(국영수사과 등) 기본값 추가');
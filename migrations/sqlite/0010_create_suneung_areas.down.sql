@@ -0,0 +1,22 @@
+DELETE FROM subject_catalog_versions WHERE version = 'suneung-areas-seed';
+DELETE FROM subjects_master WHERE subject_type = 'suneung' AND parent_code IN ('영어', '한국사', '직업탐구', '제2외국어/한문');
+CREATE TABLE subjects_master_old (
+    id                  INTEGER PRIMARY KEY AUTOINCREMENT,
+    subject_type        TEXT NOT NULL,
+    parent_code         TEXT NOT NULL,
+    code                TEXT NOT NULL,
+    name                TEXT NOT NULL,
+    display_order       INTEGER NOT NULL DEFAULT 0,
+    curriculum_version  TEXT NOT NULL DEFAULT '2015',
+    admission_year_from INTEGER,
+    admission_year_to   INTEGER,
+    UNIQUE (subject_type, parent_code, name)
+);
+INSERT OR IGNORE INTO subjects_master_old (id, subject_type, parent_code, code, name, display_order, curriculum_version, admission_year_from, admission_year_to)
+SELECT id, subject_type, parent_code, code, name, display_order, curriculum_version, admission_year_from, admission_year_to FROM subjects_master ORDER BY id;
+DROP TABLE subjects_master;
+ALTER TABLE subjects_master_old RENAME TO subjects_master;
+CREATE INDEX IF NOT EXISTS idx_subjects_master_code ON subjects_master (code);
+CREATE INDEX IF NOT EXISTS idx_subjects_master_curriculum_version ON subjects_master (curriculum_version);
+DROP TABLE IF EXISTS suneung_selection_rules;
+DROP TABLE IF EXISTS suneung_areas;
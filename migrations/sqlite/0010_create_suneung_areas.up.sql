@@ -0,0 +1,105 @@
+-- 수능 영역(원점수 만점, 절대평가 여부)과 선택 규칙(탐구 2과목, 물리학Ⅰ+물리학Ⅱ 동시 선택 불가 등)을 추가하고,
+-- 빠져 있던 영어, 한국사, 직업탐구, 제2외국어/한문 과목을 넣습니다. (handlers/suneung_catalog.go)
+-- subjects_master 의 suneung 행은 parent_code 에 영역 이름(suneung_areas.name)을 씁니다.
+
+-- 영역. payload_key 는 수능 성적 요청(userGrades.suneung.subjects)의 키이며, "explorer" 는 explorer1, explorer2 … 입니다.
+CREATE TABLE IF NOT EXISTS suneung_areas (
+    code             TEXT PRIMARY KEY,
+    name             TEXT NOT NULL UNIQUE,
+    display_order    INTEGER NOT NULL DEFAULT 0,
+    max_raw_score    INTEGER NOT NULL,
+    absolute_grading INTEGER NOT NULL DEFAULT 0, -- 1 이면 절대평가 (영어, 한국사, 제2외국어/한문)
+    payload_key      TEXT NOT NULL
+);
+
+-- 선택 규칙. rule_json: {"kind": "max_count" | "exclusive", "areas"(영역 이름), "subjects"(수능 과목 코드), "max"}
+CREATE TABLE IF NOT EXISTS suneung_selection_rules (
+    id                  INTEGER PRIMARY KEY AUTOINCREMENT,
+    code                TEXT NOT NULL UNIQUE,
+    name                TEXT NOT NULL,
+    display_order       INTEGER NOT NULL DEFAULT 0,
+    rule_json           TEXT NOT NULL,
+    curriculum_version  TEXT NOT NULL DEFAULT '2015',
+    admission_year_from INTEGER,
+    admission_year_to   INTEGER
+);
+
+INSERT OR IGNORE INTO suneung_areas (code, name, display_order, max_raw_score, absolute_grading, payload_key) VALUES
+    ('SUNEUNG_AREA_KOREAN', '국어', 1, 100, 0, 'korean'),
+    ('SUNEUNG_AREA_MATH', '수학', 2, 100, 0, 'math'),
+    ('SUNEUNG_AREA_ENGLISH', '영어', 3, 100, 1, 'english'),
+    ('SUNEUNG_AREA_KOREAN_HISTORY', '한국사', 4, 50, 1, 'history'),
+    ('SUNEUNG_AREA_SOCIAL', '사회탐구', 5, 50, 0, 'explorer'),
+    ('SUNEUNG_AREA_SCIENCE', '과학탐구', 6, 50, 0, 'explorer'),
+    ('SUNEUNG_AREA_VOCATIONAL', '직업탐구', 7, 50, 0, 'explorer'),
+    ('SUNEUNG_AREA_SECOND_FOREIGN_LANGUAGE', '제2외국어/한문', 8, 50, 1, 'foreignLanguage');
+
+-- 영어, 한국사 등은 2015/2022 개정 과목의 이름이 같으므로 과목 이름은 교육과정 버전마다 따로 겹치지 않게 합니다.
+-- SQLite 는 제약 조건을 바꿀 수 없어 테이블을 새로 만들어 옮깁니다.
+CREATE TABLE subjects_master_new (
+    id                  INTEGER PRIMARY KEY AUTOINCREMENT,
+    subject_type        TEXT NOT NULL,
+    parent_code         TEXT NOT NULL,
+    code                TEXT NOT NULL,
+    name                TEXT NOT NULL,
+    display_order       INTEGER NOT NULL DEFAULT 0,
+    curriculum_version  TEXT NOT NULL DEFAULT '2015',
+    admission_year_from INTEGER,
+    admission_year_to   INTEGER,
+    UNIQUE (subject_type, parent_code, name, curriculum_version)
+);
+INSERT INTO subjects_master_new (id, subject_type, parent_code, code, name, display_order, curriculum_version, admission_year_from, admission_year_to)
+SELECT id, subject_type, parent_code, code, name, display_order, curriculum_version, admission_year_from, admission_year_to FROM subjects_master;
+DROP TABLE subjects_master;
+ALTER TABLE subjects_master_new RENAME TO subjects_master;
+CREATE INDEX IF NOT EXISTS idx_subjects_master_code ON subjects_master (code);
+CREATE INDEX IF NOT EXISTS idx_subjects_master_curriculum_version ON subjects_master (curriculum_version);
+
+-- 선택형 수능(2022~2027학년도)과 2022 개정 수능(2028학년도~)의 영어, 한국사, 직업탐구, 제2외국어/한문
+INSERT OR IGNORE INTO subjects_master (subject_type, parent_code, code, name, display_order, curriculum_version, admission_year_from, admission_year_to) VALUES
+    ('suneung', '영어', 'SUNEUNG_영어', '영어', 3001, '2015', 2022, 2027),
+    ('suneung', '한국사', 'SUNEUNG_한국사', '한국사', 3002, '2015', 2022, 2027),
+    ('suneung', '직업탐구', 'SUNEUNG_성공적인_직업생활', '성공적인 직업생활', 3003, '2015', 2022, 2027),
+    ('suneung', '직업탐구', 'SUNEUNG_농업_기초_기술', '농업 기초 기술', 3004, '2015', 2022, 2027),
+    ('suneung', '직업탐구', 'SUNEUNG_공업_일반', '공업 일반', 3005, '2015', 2022, 2027),
+    ('suneung', '직업탐구', 'SUNEUNG_상업_경제', '상업 경제', 3006, '2015', 2022, 2027),
+    ('suneung', '직업탐구', 'SUNEUNG_수산·해운_산업_기초', '수산·해운 산업 기초', 3007, '2015', 2022, 2027),
+    ('suneung', '직업탐구', 'SUNEUNG_인간_발달', '인간 발달', 3008, '2015', 2022, 2027),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_독일어Ⅰ', '독일어Ⅰ', 3009, '2015', 2022, 2027),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_프랑스어Ⅰ', '프랑스어Ⅰ', 3010, '2015', 2022, 2027),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_스페인어Ⅰ', '스페인어Ⅰ', 3011, '2015', 2022, 2027),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_중국어Ⅰ', '중국어Ⅰ', 3012, '2015', 2022, 2027),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_일본어Ⅰ', '일본어Ⅰ', 3013, '2015', 2022, 2027),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_러시아어Ⅰ', '러시아어Ⅰ', 3014, '2015', 2022, 2027),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_아랍어Ⅰ', '아랍어Ⅰ', 3015, '2015', 2022, 2027),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_베트남어Ⅰ', '베트남어Ⅰ', 3016, '2015', 2022, 2027),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_한문Ⅰ', '한문Ⅰ', 3017, '2015', 2022, 2027),
+    ('suneung', '영어', 'SUNEUNG_2022_영어', '영어', 3018, '2022', 2028, NULL),
+    ('suneung', '한국사', 'SUNEUNG_2022_한국사', '한국사', 3019, '2022', 2028, NULL),
+    ('suneung', '직업탐구', 'SUNEUNG_2022_성공적인_직업생활', '성공적인 직업생활', 3020, '2022', 2028, NULL),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_2022_독일어', '독일어', 3021, '2022', 2028, NULL),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_2022_프랑스어', '프랑스어', 3022, '2022', 2028, NULL),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_2022_스페인어', '스페인어', 3023, '2022', 2028, NULL),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_2022_중국어', '중국어', 3024, '2022', 2028, NULL),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_2022_일본어', '일본어', 3025, '2022', 2028, NULL),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_2022_러시아어', '러시아어', 3026, '2022', 2028, NULL),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_2022_아랍어', '아랍어', 3027, '2022', 2028, NULL),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_2022_베트남어', '베트남어', 3028, '2022', 2028, NULL),
+    ('suneung', '제2외국어/한문', 'SUNEUNG_2022_한문', '한문', 3029, '2022', 2028, NULL);
+
+INSERT OR IGNORE INTO suneung_selection_rules (code, name, display_order, rule_json, curriculum_version, admission_year_from, admission_year_to) VALUES
+    ('SUNEUNG_RULE_INQUIRY_MAX_2', '탐구 2과목', 1, '{"kind": "max_count", "areas": ["사회탐구", "과학탐구", "직업탐구"], "max": 2}', '2015', 2022, 2027),
+    ('SUNEUNG_RULE_VOCATIONAL_SOCIAL', '직업탐구+사회탐구 동시 선택 불가', 2, '{"kind": "exclusive", "areas": ["직업탐구", "사회탐구"]}', '2015', 2022, 2027),
+    ('SUNEUNG_RULE_VOCATIONAL_SCIENCE', '직업탐구+과학탐구 동시 선택 불가', 3, '{"kind": "exclusive", "areas": ["직업탐구", "과학탐구"]}', '2015', 2022, 2027),
+    ('SUNEUNG_RULE_PHYSICS_1_2', '물리학Ⅰ+물리학Ⅱ 동시 선택 불가', 4, '{"kind": "exclusive", "subjects": ["SUNEUNG_물리학Ⅰ", "SUNEUNG_물리학Ⅱ"]}', '2015', 2022, 2027),
+    ('SUNEUNG_RULE_CHEMISTRY_1_2', '화학Ⅰ+화학Ⅱ 동시 선택 불가', 5, '{"kind": "exclusive", "subjects": ["SUNEUNG_화학Ⅰ", "SUNEUNG_화학Ⅱ"]}', '2015', 2022, 2027),
+    ('SUNEUNG_RULE_LIFE_SCIENCE_1_2', '생명과학Ⅰ+생명과학Ⅱ 동시 선택 불가', 6, '{"kind": "exclusive", "subjects": ["SUNEUNG_생명과학Ⅰ", "SUNEUNG_생명과학Ⅱ"]}', '2015', 2022, 2027),
+    ('SUNEUNG_RULE_EARTH_SCIENCE_1_2', '지구과학Ⅰ+지구과학Ⅱ 동시 선택 불가', 7, '{"kind": "exclusive", "subjects": ["SUNEUNG_지구과학Ⅰ", "SUNEUNG_지구과학Ⅱ"]}', '2015', 2022, 2027),
+    ('SUNEUNG_RULE_SECOND_FOREIGN_LANGUAGE_MAX_1', '제2외국어/한문 1과목', 8, '{"kind": "max_count", "areas": ["제2외국어/한문"], "max": 1}', '2015', 2022, 2027),
+    ('SUNEUNG_RULE_2022_INQUIRY_MAX_2', '탐구 2과목', 9, '{"kind": "max_count", "areas": ["사회탐구", "과학탐구", "직업탐구"], "max": 2}', '2022', 2028, NULL),
+    ('SUNEUNG_RULE_2022_VOCATIONAL_SOCIAL', '직업탐구+사회탐구 동시 선택 불가', 10, '{"kind": "exclusive", "areas": ["직업탐구", "사회탐구"]}', '2022', 2028, NULL),
+    ('SUNEUNG_RULE_2022_VOCATIONAL_SCIENCE', '직업탐구+과학탐구 동시 선택 불가', 11, '{"kind": "exclusive", "areas": ["직업탐구", "과학탐구"]}', '2022', 2028, NULL),
+    ('SUNEUNG_RULE_2022_SECOND_FOREIGN_LANGUAGE_MAX_1', '제2외국어/한문 1과목', 12, '{"kind": "max_count", "areas": ["제2외국어/한문"], "max": 1}', '2022', 2028, NULL);
+
+INSERT OR IGNORE INTO subject_catalog_versions (version, note) VALUES
+    ('suneung-areas-seed', '수능 영역, 선택 규칙, 영어/한국사/직업탐구/제2외국어 과목 추가');
@@ -0,0 +1,6 @@
+DELETE FROM subject_catalog_versions WHERE version = 'name-en-seed';
+ALTER TABLE suneung_selection_rules DROP COLUMN name_en;
+ALTER TABLE suneung_areas DROP COLUMN name_en;
+ALTER TABLE subjects_master DROP COLUMN name_en;
+ALTER TABLE subject_curriculums DROP COLUMN name_en;
+ALTER TABLE subject_classifications DROP COLUMN name_en;